@@ -4,23 +4,37 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
+	// Embed the IANA time zone database so appointment time zones validate on any host
+	_ "time/tzdata"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_logrus "github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus"
 	grpc_recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
 	grpc_ctxtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
+	"github.com/pasDamola/schedule-management-system/internal/auth"
 	"github.com/pasDamola/schedule-management-system/internal/config"
+	"github.com/pasDamola/schedule-management-system/internal/connectapi"
 	"github.com/pasDamola/schedule-management-system/internal/database"
+	"github.com/pasDamola/schedule-management-system/internal/gateway"
 	grpcServer "github.com/pasDamola/schedule-management-system/internal/grpc"
+	"github.com/pasDamola/schedule-management-system/internal/health"
+	"github.com/pasDamola/schedule-management-system/internal/httpapi"
+	"github.com/pasDamola/schedule-management-system/internal/metrics"
+	"github.com/pasDamola/schedule-management-system/internal/models"
+	"github.com/pasDamola/schedule-management-system/internal/ratelimit"
 	"github.com/pasDamola/schedule-management-system/internal/repository"
 	"github.com/pasDamola/schedule-management-system/internal/service"
+	"github.com/pasDamola/schedule-management-system/internal/tracing"
 	pb "github.com/pasDamola/schedule-management-system/pkg/pb"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
@@ -32,6 +46,22 @@ func main() {
 	// Load configuration
 	cfg := config.Load()
 	logrus.WithField("config", cfg).Info("Configuration loaded")
+	if _, err := models.LoadTimeZone(cfg.Scheduling.WorkdayTimeZone); err != nil {
+		logrus.WithError(err).Fatal("Invalid WORKDAY_TIME_ZONE")
+	}
+
+	// Send spans to the configured exporter
+	shutdownTracing, err := tracing.Setup(context.Background(), cfg.Tracing)
+	if err != nil {
+		logrus.WithError(err).Fatal("Failed to set up tracing")
+	}
+	defer func() {
+		flushCtx, flushCancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer flushCancel()
+		if err := shutdownTracing(flushCtx); err != nil {
+			logrus.WithError(err).Warn("Failed to flush traces")
+		}
+	}()
 
 	// Initialize database
 	db, err := database.NewPostgresDB(&cfg.Database)
@@ -45,14 +75,35 @@ func main() {
 		logrus.WithError(err).Fatal("Failed to run database migrations")
 	}
 
-	// Initialize repository
+	// Export connection pool statistics
+	metrics.RegisterDB(db.DB, cfg.Database.DBName)
+
+	// Initialize repositories
 	appointmentRepo := repository.NewAppointmentRepository(db)
+	calendarRepo := repository.NewCalendarRepository(db)
+	auditRepo := repository.NewAuditRepository(db)
+	feedRepo := repository.NewFeedRepository(db)
+	apiKeyRepo := repository.NewAPIKeyRepository(db)
 
 	// Initialize service
-	appointmentService := service.NewAppointmentService(appointmentRepo)
+	appointmentService := service.NewAppointmentService(appointmentRepo, calendarRepo, auditRepo, feedRepo, apiKeyRepo, cfg.Scheduling, cfg.Feeds)
+
+	// Report readiness based on the database
+	checker := health.NewChecker(db, cfg.Server.HealthCheckInterval, cfg.Server.HealthCheckTimeout)
+
+	// Require bearer tokens once a key source is configured
+	var verifier *auth.Verifier
+	if cfg.Auth.Enabled() {
+		verifier, err = auth.NewVerifier(cfg.Auth)
+		if err != nil {
+			logrus.WithError(err).Fatal("Failed to set up authentication")
+		}
+	} else {
+		logrus.Warn("Authentication is disabled; set AUTH_JWKS_URL, AUTH_JWKS_FILE or AUTH_STATIC_KEY to require bearer tokens")
+	}
 
 	// Initialize gRPC server
-	server := setupGRPCServer(appointmentService)
+	server := setupGRPCServer(appointmentService, checker, verifier, ratelimit.New(cfg.RateLimit))
 
 	// Start server
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.Server.Port))
@@ -64,6 +115,56 @@ func main() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
+	grpcAddr := fmt.Sprintf("localhost:%d", cfg.Server.Port)
+
+	// Initialize HTTP server, which also serves the API to browsers over Connect
+	// and gRPC-Web by calling the gRPC server over loopback
+	httpHandler := httpapi.NewServer(appointmentService, verifier)
+	connectPath, connectHandler, err := connectapi.NewHandler(ctx, grpcAddr)
+	if err != nil {
+		logrus.WithError(err).Fatal("Failed to set up Connect API")
+	}
+	httpHandler.Handle(connectPath, connectHandler)
+	httpHandler.Handle("GET /healthz", checker.Liveness())
+	httpHandler.Handle("GET /readyz", checker.Readiness())
+
+	// Connect and gRPC clients may use HTTP/2 without TLS
+	var httpProtocols http.Protocols
+	httpProtocols.SetHTTP1(true)
+	httpProtocols.SetUnencryptedHTTP2(true)
+	httpServer := &http.Server{
+		Addr:              fmt.Sprintf(":%d", cfg.Server.HTTPPort),
+		Handler:           httpapi.WithCORS(httpHandler, cfg.Server.CORSAllowedOrigins),
+		Protocols:         &httpProtocols,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	// Initialize REST gateway, which calls the gRPC server over loopback
+	gatewayHandler, err := gateway.NewHandler(ctx, grpcAddr)
+	if err != nil {
+		logrus.WithError(err).Fatal("Failed to set up REST gateway")
+	}
+	gatewayServer := &http.Server{
+		Addr:              fmt.Sprintf(":%d", cfg.Server.GatewayPort),
+		Handler:           gatewayHandler,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	// Metrics are served on their own port, without CORS, so they are not
+	// reachable wherever the API is
+	metricsMux := http.NewServeMux()
+	metricsMux.Handle("GET /metrics", metrics.Handler())
+	metricsServer := &http.Server{
+		Addr:              fmt.Sprintf(":%d", cfg.Server.MetricsPort),
+		Handler:           metricsMux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	// Remove expired tentative holds and old trash in the background
+	go appointmentService.RunHoldReaper(ctx)
+	go appointmentService.RunTrashPurger(ctx)
+	go checker.Run(ctx)
+
 	go func() {
 		logrus.WithField("port", cfg.Server.Port).Info("Starting gRPC server")
 		if err := server.Serve(lis); err != nil {
@@ -72,6 +173,30 @@ func main() {
 		}
 	}()
 
+	go func() {
+		logrus.WithField("port", cfg.Server.HTTPPort).Info("Starting HTTP server")
+		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			logrus.WithError(err).Error("HTTP server failed")
+			cancel()
+		}
+	}()
+
+	go func() {
+		logrus.WithField("port", cfg.Server.GatewayPort).Info("Starting REST gateway")
+		if err := gatewayServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			logrus.WithError(err).Error("REST gateway failed")
+			cancel()
+		}
+	}()
+
+	go func() {
+		logrus.WithField("port", cfg.Server.MetricsPort).Info("Starting metrics server")
+		if err := metricsServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			logrus.WithError(err).Error("Metrics server failed")
+			cancel()
+		}
+	}()
+
 	// Wait for interrupt signal
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
@@ -84,10 +209,22 @@ func main() {
 	}
 
 	// Graceful shutdown
-	logrus.Info("Shutting down gRPC server...")
+	logrus.Info("Shutting down servers...")
+	checker.Shutdown()
+
 	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer shutdownCancel()
 
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		logrus.WithError(err).Warn("HTTP server shutdown failed")
+	}
+	if err := gatewayServer.Shutdown(shutdownCtx); err != nil {
+		logrus.WithError(err).Warn("REST gateway shutdown failed")
+	}
+	if err := metricsServer.Shutdown(shutdownCtx); err != nil {
+		logrus.WithError(err).Warn("Metrics server shutdown failed")
+	}
+
 	done := make(chan struct{})
 	go func() {
 		server.GracefulStop()
@@ -103,22 +240,39 @@ func main() {
 	}
 }
 
-func setupGRPCServer(appointmentService service.AppointmentService) *grpc.Server {
+func setupGRPCServer(appointmentService service.AppointmentService, checker *health.Checker, verifier *auth.Verifier, limiter *ratelimit.Limiter) *grpc.Server {
 	// Setup logging
 	logrusEntry := logrus.NewEntry(logrus.StandardLogger())
 	
-	// Setup gRPC middleware
+	// Setup gRPC middleware; recovery runs first so a panic in any later
+	// interceptor fails only its call, and authentication runs after logging
+	// and metrics so rejected calls are still counted
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		grpc_recovery.StreamServerInterceptor(),
+		grpc_ctxtags.StreamServerInterceptor(),
+		grpcServer.RequestInfoStreamInterceptor(),
+		grpcServer.MetricsStreamInterceptor(),
+		grpc_logrus.StreamServerInterceptor(logrusEntry),
+	}
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		grpc_recovery.UnaryServerInterceptor(),
+		grpc_ctxtags.UnaryServerInterceptor(),
+		grpcServer.RequestInfoUnaryInterceptor(),
+		grpcServer.MetricsUnaryInterceptor(),
+		grpc_logrus.UnaryServerInterceptor(logrusEntry),
+	}
+	// API keys are checked even while bearer tokens are not required
+	streamInterceptors = append(streamInterceptors, grpcServer.AuthStreamInterceptor(verifier, appointmentService))
+	unaryInterceptors = append(unaryInterceptors, grpcServer.AuthUnaryInterceptor(verifier, appointmentService))
+	// Rate limits run after authentication so they can tell callers apart
+	streamInterceptors = append(streamInterceptors, grpcServer.RateLimitStreamInterceptor(limiter))
+	unaryInterceptors = append(unaryInterceptors, grpcServer.RateLimitUnaryInterceptor(limiter))
+
 	opts := []grpc.ServerOption{
-		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
-			grpc_ctxtags.StreamServerInterceptor(),
-			grpc_logrus.StreamServerInterceptor(logrusEntry),
-			grpc_recovery.StreamServerInterceptor(),
-		)),
-		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
-			grpc_ctxtags.UnaryServerInterceptor(),
-			grpc_logrus.UnaryServerInterceptor(logrusEntry),
-			grpc_recovery.UnaryServerInterceptor(),
-		)),
+		// Start a span per RPC, continuing the trace in the incoming metadata
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(streamInterceptors...)),
+		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(unaryInterceptors...)),
 	}
 
 	server := grpc.NewServer(opts...)
@@ -126,6 +280,7 @@ func setupGRPCServer(appointmentService service.AppointmentService) *grpc.Server
 	// Register services
 	appointmentServer := grpcServer.NewAppointmentServer(appointmentService)
 	pb.RegisterAppointmentServiceServer(server, appointmentServer)
+	healthpb.RegisterHealthServer(server, checker.Server())
 
 	// Enable reflection for development
 	reflection.Register(server)
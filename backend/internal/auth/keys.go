@@ -0,0 +1,143 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/sirupsen/logrus"
+)
+
+// KeySource provides the keys tokens may be signed with
+type KeySource interface {
+	// Keys returns the keys matching kid, or every key when kid is empty
+	Keys(ctx context.Context, kid string) ([]jose.JSONWebKey, error)
+}
+
+// staticKeys is a key set that never changes
+type staticKeys struct {
+	set jose.JSONWebKeySet
+}
+
+// NewStaticKey returns a source holding a single HMAC secret
+func NewStaticKey(secret string) KeySource {
+	return &staticKeys{set: jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{Key: []byte(secret)}}}}
+}
+
+// NewJWKSFile returns a source holding the key set read from path
+func NewJWKSFile(path string) (KeySource, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read JWKS file: %v", err)
+	}
+	var set jose.JSONWebKeySet
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("failed to parse JWKS file: %v", err)
+	}
+	if len(set.Keys) == 0 {
+		return nil, fmt.Errorf("JWKS file %s holds no keys", path)
+	}
+	return &staticKeys{set: set}, nil
+}
+
+func (s *staticKeys) Keys(ctx context.Context, kid string) ([]jose.JSONWebKey, error) {
+	return matchKeys(&s.set, kid), nil
+}
+
+// minFetchInterval limits how often the key set is fetched when the provider
+// fails or a token names an unknown key, so neither can hammer the provider
+const minFetchInterval = 30 * time.Second
+
+// remoteKeys caches the key set published at a JWKS URL
+type remoteKeys struct {
+	url      string
+	client   *http.Client
+	interval time.Duration
+
+	mutex       sync.Mutex
+	set         *jose.JSONWebKeySet
+	fetchedAt   time.Time
+	attemptedAt time.Time
+}
+
+// NewJWKSURL returns a source that fetches the key set at url on first use and
+// again every interval, or sooner when a token names a key it does not know
+func NewJWKSURL(url string, interval time.Duration) KeySource {
+	return &remoteKeys{
+		url:      url,
+		client:   &http.Client{Timeout: 10 * time.Second},
+		interval: interval,
+	}
+}
+
+func (r *remoteKeys) Keys(ctx context.Context, kid string) ([]jose.JSONWebKey, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.set == nil || time.Since(r.fetchedAt) > r.interval {
+		r.fetch(ctx)
+	}
+	if r.set == nil {
+		return nil, fmt.Errorf("no JWKS fetched from %s yet", r.url)
+	}
+
+	keys := matchKeys(r.set, kid)
+	if len(keys) == 0 && kid != "" {
+		// The provider may have rotated its keys since the last fetch
+		r.fetch(ctx)
+		keys = matchKeys(r.set, kid)
+	}
+	return keys, nil
+}
+
+// fetch replaces the cached key set, unless it was attempted less than
+// minFetchInterval ago. On failure the previous set, if any, is kept, so an
+// identity provider outage does not lock every caller out.
+func (r *remoteKeys) fetch(ctx context.Context) {
+	if time.Since(r.attemptedAt) < minFetchInterval {
+		return
+	}
+	r.attemptedAt = time.Now()
+
+	// The result serves every caller, so it should not depend on whether the
+	// request that triggered the fetch is still waiting
+	log := logrus.WithField("url", r.url)
+	req, err := http.NewRequestWithContext(context.WithoutCancel(ctx), http.MethodGet, r.url, nil)
+	if err != nil {
+		log.WithError(err).Error("Failed to build JWKS request")
+		return
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		log.WithError(err).Warn("Failed to fetch JWKS")
+		return
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		log.WithField("status", resp.StatusCode).Warn("Failed to fetch JWKS")
+		return
+	}
+
+	var set jose.JSONWebKeySet
+	if err := json.NewDecoder(resp.Body).Decode(&set); err != nil {
+		log.WithError(err).Warn("Failed to parse JWKS")
+		return
+	}
+
+	r.set = &set
+	r.fetchedAt = time.Now()
+	log.WithField("keys", len(set.Keys)).Info("JWKS fetched")
+}
+
+func matchKeys(set *jose.JSONWebKeySet, kid string) []jose.JSONWebKey {
+	if kid == "" {
+		return set.Keys
+	}
+	return set.Key(kid)
+}
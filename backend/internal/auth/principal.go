@@ -0,0 +1,43 @@
+// Package auth verifies the bearer tokens sent by callers and carries the
+// resulting principal through the request context.
+package auth
+
+import "context"
+
+// Principal is an authenticated caller
+type Principal struct {
+	// Subject is the token's sub claim, which identifies the caller within Issuer
+	Subject string
+	Issuer  string
+	Roles   []string
+	// Tenant is the tenant whose data the caller works on, empty for the default one
+	Tenant string
+	// Scopes limit an API key to the methods they cover; nil places no limit
+	Scopes []string
+}
+
+// HasScope reports whether the principal may call methods needing scope
+func (p *Principal) HasScope(scope string) bool {
+	if p.Scopes == nil {
+		return true
+	}
+	for _, granted := range p.Scopes {
+		if granted == scope {
+			return true
+		}
+	}
+	return false
+}
+
+type contextKey struct{}
+
+// NewContext returns a copy of ctx carrying principal
+func NewContext(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, contextKey{}, principal)
+}
+
+// FromContext returns the principal stored in ctx, if the caller authenticated
+func FromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(contextKey{}).(*Principal)
+	return principal, ok
+}
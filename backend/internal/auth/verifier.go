@@ -0,0 +1,176 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/pasDamola/schedule-management-system/internal/config"
+	"github.com/pasDamola/schedule-management-system/internal/requestinfo"
+)
+
+var (
+	ErrMissingToken = errors.New("missing bearer token")
+	ErrInvalidToken = errors.New("invalid bearer token")
+)
+
+// asymmetricAlgorithms are accepted from JWKS, whose keys are public
+var asymmetricAlgorithms = []jose.SignatureAlgorithm{
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.PS256, jose.PS384, jose.PS512,
+	jose.ES256, jose.ES384, jose.ES512,
+	jose.EdDSA,
+}
+
+// hmacAlgorithms are accepted with a static shared secret only
+var hmacAlgorithms = []jose.SignatureAlgorithm{jose.HS256, jose.HS384, jose.HS512}
+
+// minStaticKeyLength is the smallest secret HS256 accepts
+const minStaticKeyLength = 32
+
+// clockSkew is how far exp and nbf may be off to allow for clock drift
+const clockSkew = time.Minute
+
+// Verifier checks bearer JWTs and turns their claims into a Principal
+type Verifier struct {
+	keys        KeySource
+	algorithms  []jose.SignatureAlgorithm
+	issuer      string
+	audience    string
+	rolesClaim  string
+	tenantClaim string
+}
+
+// NewVerifier builds a verifier from the single key source set in cfg
+func NewVerifier(cfg config.AuthConfig) (*Verifier, error) {
+	v := &Verifier{
+		algorithms:  asymmetricAlgorithms,
+		issuer:      cfg.Issuer,
+		audience:    cfg.Audience,
+		rolesClaim:  cfg.RolesClaim,
+		tenantClaim: cfg.TenantClaim,
+	}
+
+	sources := 0
+	for _, value := range []string{cfg.JWKSURL, cfg.JWKSFile, cfg.StaticKey} {
+		if value != "" {
+			sources++
+		}
+	}
+	if sources != 1 {
+		return nil, fmt.Errorf("exactly one of the JWKS URL, JWKS file and static key must be set")
+	}
+
+	switch {
+	case cfg.StaticKey != "":
+		if len(cfg.StaticKey) < minStaticKeyLength {
+			return nil, fmt.Errorf("static key must be at least %d bytes long", minStaticKeyLength)
+		}
+		v.keys = NewStaticKey(cfg.StaticKey)
+		v.algorithms = hmacAlgorithms
+	case cfg.JWKSFile != "":
+		keys, err := NewJWKSFile(cfg.JWKSFile)
+		if err != nil {
+			return nil, err
+		}
+		v.keys = keys
+	default:
+		v.keys = NewJWKSURL(cfg.JWKSURL, cfg.JWKSRefreshInterval)
+	}
+
+	return v, nil
+}
+
+// Verify checks the signature and registered claims of token and returns the
+// principal it identifies. Any problem with the token itself is reported as
+// ErrInvalidToken, wrapped with the reason.
+func (v *Verifier) Verify(ctx context.Context, token string) (*Principal, error) {
+	parsed, err := jwt.ParseSigned(token, v.algorithms)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	kid := ""
+	if len(parsed.Headers) > 0 {
+		kid = parsed.Headers[0].KeyID
+	}
+	keys, err := v.keys.Keys(ctx, kid)
+	if err != nil {
+		return nil, err
+	}
+
+	var claims jwt.Claims
+	var custom map[string]interface{}
+	verified := false
+	for _, key := range keys {
+		// A key set may list private keys, which verify through their public half
+		if public := key.Public(); public.Key != nil {
+			key = public
+		}
+		if err := parsed.Claims(key, &claims, &custom); err == nil {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		return nil, fmt.Errorf("%w: signature does not match any key", ErrInvalidToken)
+	}
+
+	expected := jwt.Expected{Issuer: v.issuer, Time: time.Now()}
+	if v.audience != "" {
+		expected.AnyAudience = jwt.Audience{v.audience}
+	}
+	if err := claims.ValidateWithLeeway(expected, clockSkew); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	if claims.Expiry == nil {
+		return nil, fmt.Errorf("%w: token has no expiry", ErrInvalidToken)
+	}
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("%w: token has no subject", ErrInvalidToken)
+	}
+
+	tenant, _ := custom[v.tenantClaim].(string)
+	if len(tenant) > requestinfo.MaxTenantLength {
+		return nil, fmt.Errorf("%w: tenant is longer than %d characters", ErrInvalidToken, requestinfo.MaxTenantLength)
+	}
+
+	return &Principal{
+		Subject: claims.Subject,
+		Issuer:  claims.Issuer,
+		Roles:   stringList(custom[v.rolesClaim]),
+		Tenant:  tenant,
+	}, nil
+}
+
+// BearerToken extracts the token from an Authorization header value
+func BearerToken(header string) (string, error) {
+	scheme, token, ok := strings.Cut(strings.TrimSpace(header), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(token) == "" {
+		return "", ErrMissingToken
+	}
+	return strings.TrimSpace(token), nil
+}
+
+// stringList reads a claim holding either a list of strings or a single
+// space-separated string, as OAuth scopes are
+func stringList(claim interface{}) []string {
+	switch value := claim.(type) {
+	case string:
+		return strings.Fields(value)
+	case []interface{}:
+		var values []string
+		for _, item := range value {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	default:
+		return nil
+	}
+}
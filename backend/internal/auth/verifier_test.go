@@ -0,0 +1,198 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/pasDamola/schedule-management-system/internal/config"
+	"github.com/pasDamola/schedule-management-system/internal/requestinfo"
+)
+
+const testSecret = "0123456789abcdef0123456789abcdef"
+
+// sign serializes claims as a JWT signed with key using alg, naming the key
+// kid when it is set
+func sign(t *testing.T, alg jose.SignatureAlgorithm, key interface{}, kid string, claims map[string]interface{}) string {
+	t.Helper()
+	opts := (&jose.SignerOptions{}).WithType("JWT")
+	if kid != "" {
+		opts = opts.WithHeader("kid", kid)
+	}
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: alg, Key: key}, opts)
+	if err != nil {
+		t.Fatalf("NewSigner: %v", err)
+	}
+	token, err := jwt.Signed(signer).Claims(claims).Serialize()
+	if err != nil {
+		t.Fatalf("Serialize: %v", err)
+	}
+	return token
+}
+
+// validClaims are claims every verifier accepts, overridden by extra
+func validClaims(extra map[string]interface{}) map[string]interface{} {
+	claims := map[string]interface{}{
+		"sub": "user-1",
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}
+	for name, value := range extra {
+		if value == nil {
+			delete(claims, name)
+		} else {
+			claims[name] = value
+		}
+	}
+	return claims
+}
+
+// newJWKSFileVerifier returns a verifier trusting the public half of key
+func newJWKSFileVerifier(t *testing.T, key *ecdsa.PrivateKey) *Verifier {
+	t.Helper()
+	set := jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+		{Key: &key.PublicKey, KeyID: "test", Algorithm: string(jose.ES256), Use: "sig"},
+	}}
+	data, err := json.Marshal(set)
+	if err != nil {
+		t.Fatalf("marshal JWKS: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("write JWKS: %v", err)
+	}
+
+	v, err := NewVerifier(config.AuthConfig{JWKSFile: path, TenantClaim: "tenant"})
+	if err != nil {
+		t.Fatalf("NewVerifier: %v", err)
+	}
+	return v
+}
+
+func TestVerifyAlgorithms(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	publicDER, err := x509.MarshalPKIXPublicKey(&ecKey.PublicKey)
+	if err != nil {
+		t.Fatalf("MarshalPKIXPublicKey: %v", err)
+	}
+
+	static, err := NewVerifier(config.AuthConfig{StaticKey: testSecret, TenantClaim: "tenant"})
+	if err != nil {
+		t.Fatalf("NewVerifier: %v", err)
+	}
+	jwks := newJWKSFileVerifier(t, ecKey)
+
+	tests := []struct {
+		name     string
+		verifier *Verifier
+		alg      jose.SignatureAlgorithm
+		key      interface{}
+		kid      string
+		wantErr  bool
+	}{
+		{name: "static key accepts HS256", verifier: static, alg: jose.HS256, key: []byte(testSecret)},
+		{name: "static key rejects ES256", verifier: static, alg: jose.ES256, key: ecKey, wantErr: true},
+		{name: "JWKS accepts ES256", verifier: jwks, alg: jose.ES256, key: ecKey, kid: "test"},
+		// An HMAC token keyed with the public key must not pass as signed by it
+		{name: "JWKS rejects HS256", verifier: jwks, alg: jose.HS256, key: publicDER, kid: "test", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token := sign(t, tt.alg, tt.key, tt.kid, validClaims(nil))
+			_, err := tt.verifier.Verify(context.Background(), token)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Verify() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidToken) {
+				t.Errorf("Verify() error = %v, want ErrInvalidToken", err)
+			}
+		})
+	}
+}
+
+func TestVerifyClaims(t *testing.T) {
+	v, err := NewVerifier(config.AuthConfig{StaticKey: testSecret, TenantClaim: "tenant", RolesClaim: "roles"})
+	if err != nil {
+		t.Fatalf("NewVerifier: %v", err)
+	}
+	now := time.Now()
+
+	tests := []struct {
+		name       string
+		claims     map[string]interface{}
+		wantErr    bool
+		wantTenant string
+	}{
+		{name: "valid", claims: validClaims(nil)},
+		{name: "expired within skew", claims: validClaims(map[string]interface{}{"exp": now.Add(-clockSkew / 2).Unix()})},
+		{name: "expired beyond skew", claims: validClaims(map[string]interface{}{"exp": now.Add(-2 * clockSkew).Unix()}), wantErr: true},
+		{name: "not yet valid within skew", claims: validClaims(map[string]interface{}{"nbf": now.Add(clockSkew / 2).Unix()})},
+		{name: "not yet valid beyond skew", claims: validClaims(map[string]interface{}{"nbf": now.Add(2 * clockSkew).Unix()}), wantErr: true},
+		{name: "missing expiry", claims: validClaims(map[string]interface{}{"exp": nil}), wantErr: true},
+		{name: "missing subject", claims: validClaims(map[string]interface{}{"sub": nil}), wantErr: true},
+		{
+			name:       "longest tenant",
+			claims:     validClaims(map[string]interface{}{"tenant": strings.Repeat("t", requestinfo.MaxTenantLength)}),
+			wantTenant: strings.Repeat("t", requestinfo.MaxTenantLength),
+		},
+		{
+			name:    "tenant too long",
+			claims:  validClaims(map[string]interface{}{"tenant": strings.Repeat("t", requestinfo.MaxTenantLength+1)}),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token := sign(t, jose.HS256, []byte(testSecret), "", tt.claims)
+			principal, err := v.Verify(context.Background(), token)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Verify() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if !errors.Is(err, ErrInvalidToken) {
+					t.Errorf("Verify() error = %v, want ErrInvalidToken", err)
+				}
+				return
+			}
+			if principal.Subject != "user-1" || principal.Tenant != tt.wantTenant {
+				t.Errorf("Verify() = %+v, want subject user-1 and tenant %q", principal, tt.wantTenant)
+			}
+		})
+	}
+}
+
+func TestNewVerifier(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     config.AuthConfig
+		wantErr bool
+	}{
+		{name: "static key", cfg: config.AuthConfig{StaticKey: testSecret}},
+		{name: "short static key", cfg: config.AuthConfig{StaticKey: testSecret[:minStaticKeyLength-1]}, wantErr: true},
+		{name: "no key source", cfg: config.AuthConfig{}, wantErr: true},
+		{name: "two key sources", cfg: config.AuthConfig{StaticKey: testSecret, JWKSURL: "https://idp.example.com/jwks.json"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewVerifier(tt.cfg); (err != nil) != tt.wantErr {
+				t.Errorf("NewVerifier() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -3,11 +3,18 @@ package config
 import (
 	"os"
 	"strconv"
+	"strings"
+	"time"
 )
 
 type Config struct {
-	Database DatabaseConfig
-	Server   ServerConfig
+	Database   DatabaseConfig
+	Server     ServerConfig
+	Scheduling SchedulingConfig
+	Feeds      FeedConfig
+	Tracing    TracingConfig
+	Auth       AuthConfig
+	RateLimit  RateLimitConfig
 }
 
 type DatabaseConfig struct {
@@ -21,6 +28,101 @@ type DatabaseConfig struct {
 
 type ServerConfig struct {
 	Port int
+	// HTTPPort serves the plain HTTP endpoints such as iCalendar exports
+	HTTPPort int
+	// GatewayPort serves the REST/JSON gateway to the gRPC API
+	GatewayPort int
+	// MetricsPort serves Prometheus metrics; keep it off the public network
+	MetricsPort int
+	// CORSAllowedOrigins may call the HTTP port from a browser; "*" allows any origin
+	CORSAllowedOrigins []string
+	// The database is pinged every HealthCheckInterval to decide readiness
+	HealthCheckInterval time.Duration
+	HealthCheckTimeout  time.Duration
+}
+
+// SchedulingConfig holds the business rules applied to appointments
+type SchedulingConfig struct {
+	WorkdayStartHour int
+	WorkdayEndHour   int
+	// WorkdayTimeZone is the IANA zone working hours and statistics are reported in
+	// unless a request names its own
+	WorkdayTimeZone string
+	// AllDayExemptFromDurationLimits lets all-day events exceed the 8 hour limit
+	AllDayExemptFromDurationLimits bool
+	// Tentative holds expire after HoldTTL unless a shorter TTL is requested
+	HoldTTL            time.Duration
+	MaxHoldTTL         time.Duration
+	HoldReaperInterval time.Duration
+	// Deleted appointments stay in the trash for TrashRetention before being purged
+	TrashRetention     time.Duration
+	TrashPurgeInterval time.Duration
+}
+
+// FeedConfig controls the subscribable iCalendar feeds served over HTTP
+type FeedConfig struct {
+	// BaseURL is where the HTTP server is reachable by calendar apps
+	BaseURL string
+	// Lookback is how far into the past feeds reach
+	Lookback time.Duration
+}
+
+// TracingConfig controls where OpenTelemetry spans are sent
+type TracingConfig struct {
+	// Exporter is "otlp", "stdout" or "none"
+	Exporter string
+	// OTLPEndpoint is the host:port of an OTLP/gRPC collector
+	OTLPEndpoint string
+	OTLPInsecure bool
+	ServiceName  string
+	// SampleRatio is the fraction of new traces recorded; incoming sampled
+	// traces are always recorded
+	SampleRatio float64
+}
+
+// AuthConfig controls how bearer tokens are verified. Authentication is
+// required as soon as one key source is set, and off otherwise.
+type AuthConfig struct {
+	// JWKSURL is fetched again every JWKSRefreshInterval, or sooner when a
+	// token names an unknown key
+	JWKSURL             string
+	JWKSFile            string
+	JWKSRefreshInterval time.Duration
+	// StaticKey is a shared HMAC secret, meant for tests and local development
+	StaticKey string `json:"-"`
+	// Issuer and Audience, when set, must match the token's iss and aud claims
+	Issuer   string
+	Audience string
+	// RolesClaim names the claim listing the caller's roles
+	RolesClaim string
+	// TenantClaim names the claim holding the caller's tenant
+	TenantClaim string
+}
+
+// RateLimit allows Rate calls per second on average and bursts of up to Burst
+// calls; a Rate of 0 places no limit
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+// RateLimitConfig limits how fast each client may call the gRPC API. A client
+// is the authenticated caller or API key, or the peer address when
+// authentication is off.
+type RateLimitConfig struct {
+	// Limit applies to all of a client's calls together, except for methods
+	// in Methods, which have a limit of their own
+	Limit RateLimit
+	// Methods are keyed by method name, such as CreateAppointment
+	Methods map[string]RateLimit
+	// MaxStreams caps the concurrent StreamAppointments calls per client; 0
+	// places no cap
+	MaxStreams int
+}
+
+// Enabled reports whether a key source is configured
+func (c AuthConfig) Enabled() bool {
+	return c.JWKSURL != "" || c.JWKSFile != "" || c.StaticKey != ""
 }
 
 func Load() *Config {
@@ -34,7 +136,62 @@ func Load() *Config {
 			SSLMode:  getEnv("DB_SSLMODE", "disable"),
 		},
 		Server: ServerConfig{
-			Port: getEnvAsInt("SERVER_PORT", 50051),
+			Port:     getEnvAsInt("SERVER_PORT", 50051),
+			HTTPPort: getEnvAsInt("HTTP_PORT", 8081),
+
+			GatewayPort: getEnvAsInt("GATEWAY_PORT", 8082),
+			MetricsPort: getEnvAsInt("METRICS_PORT", 9090),
+
+			CORSAllowedOrigins: getEnvAsList("CORS_ALLOWED_ORIGINS", []string{"*"}),
+
+			HealthCheckInterval: getEnvAsDuration("HEALTH_CHECK_INTERVAL", 10*time.Second),
+			HealthCheckTimeout:  getEnvAsDuration("HEALTH_CHECK_TIMEOUT", 2*time.Second),
+		},
+		Scheduling: SchedulingConfig{
+			WorkdayStartHour: getEnvAsInt("WORKDAY_START_HOUR", 9),
+			WorkdayEndHour:   getEnvAsInt("WORKDAY_END_HOUR", 17),
+			WorkdayTimeZone:  getEnv("WORKDAY_TIME_ZONE", "UTC"),
+
+			AllDayExemptFromDurationLimits: getEnvAsBool("ALL_DAY_EXEMPT_FROM_DURATION_LIMITS", true),
+
+			HoldTTL:            getEnvAsDuration("HOLD_TTL", 5*time.Minute),
+			MaxHoldTTL:         getEnvAsDuration("MAX_HOLD_TTL", 30*time.Minute),
+			HoldReaperInterval: getEnvAsDuration("HOLD_REAPER_INTERVAL", 30*time.Second),
+			TrashRetention:     getEnvAsDuration("TRASH_RETENTION", 30*24*time.Hour),
+			TrashPurgeInterval: getEnvAsDuration("TRASH_PURGE_INTERVAL", time.Hour),
+		},
+		Feeds: FeedConfig{
+			BaseURL:  getEnv("FEED_BASE_URL", "http://localhost:8081"),
+			Lookback: getEnvAsDuration("FEED_LOOKBACK", 30*24*time.Hour),
+		},
+		Tracing: TracingConfig{
+			Exporter:     getEnv("TRACING_EXPORTER", "none"),
+			OTLPEndpoint: getEnv("TRACING_OTLP_ENDPOINT", "localhost:4317"),
+			OTLPInsecure: getEnvAsBool("TRACING_OTLP_INSECURE", true),
+			ServiceName:  getEnv("TRACING_SERVICE_NAME", "schedule-management"),
+			SampleRatio:  getEnvAsFloat("TRACING_SAMPLE_RATIO", 1),
+		},
+		Auth: AuthConfig{
+			JWKSURL:             getEnv("AUTH_JWKS_URL", ""),
+			JWKSFile:            getEnv("AUTH_JWKS_FILE", ""),
+			JWKSRefreshInterval: getEnvAsDuration("AUTH_JWKS_REFRESH_INTERVAL", time.Hour),
+			StaticKey:           getEnv("AUTH_STATIC_KEY", ""),
+			Issuer:              getEnv("AUTH_ISSUER", ""),
+			Audience:            getEnv("AUTH_AUDIENCE", ""),
+			RolesClaim:          getEnv("AUTH_ROLES_CLAIM", "roles"),
+			TenantClaim:         getEnv("AUTH_TENANT_CLAIM", "tenant"),
+		},
+		RateLimit: RateLimitConfig{
+			Limit: RateLimit{
+				Rate:  getEnvAsFloat("RATE_LIMIT_RATE", 50),
+				Burst: getEnvAsInt("RATE_LIMIT_BURST", 100),
+			},
+			Methods: getEnvAsRateLimits("RATE_LIMIT_METHODS", map[string]RateLimit{
+				"CreateAppointment":       {Rate: 5, Burst: 20},
+				"BatchCreateAppointments": {Rate: 1, Burst: 5},
+				"ImportAppointments":      {Rate: 0.2, Burst: 2},
+			}),
+			MaxStreams: getEnvAsInt("RATE_LIMIT_MAX_STREAMS", 5),
 		},
 	}
 }
@@ -53,4 +210,74 @@ func getEnvAsInt(key string, defaultValue int) int {
 		}
 	}
 	return defaultValue
-}
\ No newline at end of file
+}
+
+func getEnvAsBool(key string, defaultValue bool) bool {
+	if value := os.Getenv(key); value != "" {
+		if boolVal, err := strconv.ParseBool(value); err == nil {
+			return boolVal
+		}
+	}
+	return defaultValue
+}
+
+func getEnvAsFloat(key string, defaultValue float64) float64 {
+	if value := os.Getenv(key); value != "" {
+		if floatVal, err := strconv.ParseFloat(value, 64); err == nil {
+			return floatVal
+		}
+	}
+	return defaultValue
+}
+
+// getEnvAsList reads a comma-separated list, ignoring empty items
+func getEnvAsList(key string, defaultValue []string) []string {
+	var values []string
+	for _, value := range strings.Split(os.Getenv(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	if len(values) == 0 {
+		return defaultValue
+	}
+	return values
+}
+
+// getEnvAsRateLimits reads a comma-separated list of method=rate:burst items,
+// such as "CreateAppointment=5:20", ignoring malformed items
+func getEnvAsRateLimits(key string, defaultValue map[string]RateLimit) map[string]RateLimit {
+	limits := make(map[string]RateLimit)
+	for _, item := range strings.Split(os.Getenv(key), ",") {
+		method, value, ok := strings.Cut(strings.TrimSpace(item), "=")
+		if !ok || method == "" {
+			continue
+		}
+		rate, burst, ok := strings.Cut(value, ":")
+		if !ok {
+			continue
+		}
+		rateVal, err := strconv.ParseFloat(rate, 64)
+		if err != nil || rateVal < 0 {
+			continue
+		}
+		burstVal, err := strconv.Atoi(burst)
+		if err != nil || burstVal < 0 {
+			continue
+		}
+		limits[method] = RateLimit{Rate: rateVal, Burst: burstVal}
+	}
+	if len(limits) == 0 {
+		return defaultValue
+	}
+	return limits
+}
+
+func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
+	if value := os.Getenv(key); value != "" {
+		if durationVal, err := time.ParseDuration(value); err == nil {
+			return durationVal
+		}
+	}
+	return defaultValue
+}
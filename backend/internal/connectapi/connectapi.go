@@ -0,0 +1,157 @@
+// Package connectapi serves the AppointmentService over the Connect, gRPC-Web and
+// gRPC protocols from a plain net/http server, so browsers can call it without a
+// separate gRPC-Web proxy. Calls are relayed to the gRPC server, where they pass
+// through the same interceptors as native gRPC calls.
+package connectapi
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+
+	"connectrpc.com/connect"
+	grpcServer "github.com/pasDamola/schedule-management-system/internal/grpc"
+	"github.com/pasDamola/schedule-management-system/internal/tracing"
+	pb "github.com/pasDamola/schedule-management-system/pkg/pb"
+	"github.com/pasDamola/schedule-management-system/pkg/pb/pbconnect"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// forwardedHeaders are passed on to the gRPC server as metadata of the same name
+var forwardedHeaders = append([]string{"authorization", "x-actor", "x-api-key", "x-tenant-id", "x-request-id"}, tracing.PropagatedHeaders...)
+
+// NewHandler returns the path prefix and handler of the AppointmentService,
+// relaying calls to the gRPC server at grpcAddr. The connection is closed when
+// ctx is done.
+func NewHandler(ctx context.Context, grpcAddr string) (string, http.Handler, error) {
+	conn, err := grpc.NewClient(grpcAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return "", nil, err
+	}
+	go func() {
+		<-ctx.Done()
+		conn.Close()
+	}()
+
+	path, handler := pbconnect.NewAppointmentServiceHandler(&relay{client: pb.NewAppointmentServiceClient(conn)})
+	return path, handler, nil
+}
+
+// outgoingContext carries the forwarded request headers and the client's
+// address to the gRPC server
+func outgoingContext(ctx context.Context, peer connect.Peer, header http.Header) context.Context {
+	host := peer.Addr
+	if h, _, err := net.SplitHostPort(peer.Addr); err == nil {
+		host = h
+	}
+	pairs := []string{grpcServer.ClientAddressHeader, host}
+	for _, key := range forwardedHeaders {
+		for _, value := range header.Values(key) {
+			pairs = append(pairs, key, value)
+		}
+	}
+	return metadata.AppendToOutgoingContext(ctx, pairs...)
+}
+
+// copyHeader returns forwarded response metadata, such as the request ID, as headers
+func copyHeader(header http.Header, md metadata.MD) {
+	for _, key := range forwardedHeaders {
+		for _, value := range md.Get(key) {
+			header.Add(key, value)
+		}
+	}
+}
+
+// connectError converts a gRPC status, with its details, to a Connect error
+func connectError(err error, md metadata.MD) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return connect.NewError(connect.CodeUnknown, err)
+	}
+
+	connectErr := connect.NewError(connect.Code(st.Code()), errors.New(st.Message()))
+	for _, detail := range st.Proto().GetDetails() {
+		if errorDetail, err := connect.NewErrorDetail(detail); err == nil {
+			connectErr.AddDetail(errorDetail)
+		}
+	}
+	copyHeader(connectErr.Meta(), md)
+	return connectErr
+}
+
+func unary[Req, Res any](ctx context.Context, req *connect.Request[Req], call func(context.Context, *Req, ...grpc.CallOption) (*Res, error)) (*connect.Response[Res], error) {
+	var header metadata.MD
+	res, err := call(outgoingContext(ctx, req.Peer(), req.Header()), req.Msg, grpc.Header(&header))
+	if err != nil {
+		return nil, connectError(err, header)
+	}
+
+	response := connect.NewResponse(res)
+	copyHeader(response.Header(), header)
+	return response, nil
+}
+
+func serverStream[Req, Res any](ctx context.Context, req *connect.Request[Req], stream *connect.ServerStream[Res], call func(context.Context, *Req, ...grpc.CallOption) (grpc.ServerStreamingClient[Res], error)) error {
+	ctx, cancel := context.WithCancel(outgoingContext(ctx, req.Peer(), req.Header()))
+	defer cancel()
+
+	client, err := call(ctx, req.Msg)
+	if err != nil {
+		return connectError(err, nil)
+	}
+	// Headers arrive before the first message, or not at all if the call fails
+	if header, err := client.Header(); err == nil {
+		copyHeader(stream.ResponseHeader(), header)
+	}
+
+	for {
+		msg, err := client.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return connectError(err, nil)
+		}
+		if err := stream.Send(msg); err != nil {
+			return err
+		}
+	}
+}
+
+func clientStream[Req, Res any](ctx context.Context, stream *connect.ClientStream[Req], call func(context.Context, ...grpc.CallOption) (grpc.ClientStreamingClient[Req, Res], error)) (*connect.Response[Res], error) {
+	ctx, cancel := context.WithCancel(outgoingContext(ctx, stream.Peer(), stream.RequestHeader()))
+	defer cancel()
+
+	var header metadata.MD
+	client, err := call(ctx, grpc.Header(&header))
+	if err != nil {
+		return nil, connectError(err, nil)
+	}
+
+	for stream.Receive() {
+		// io.EOF means the server has already answered; CloseAndRecv returns its status
+		if err := client.Send(stream.Msg()); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, connectError(err, nil)
+		}
+	}
+	if err := stream.Err(); err != nil {
+		return nil, err
+	}
+
+	res, err := client.CloseAndRecv()
+	if err != nil {
+		return nil, connectError(err, header)
+	}
+
+	response := connect.NewResponse(res)
+	copyHeader(response.Header(), header)
+	return response, nil
+}
@@ -0,0 +1,134 @@
+package connectapi
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	pb "github.com/pasDamola/schedule-management-system/pkg/pb"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// relay implements the Connect handler by calling the gRPC server
+type relay struct {
+	client pb.AppointmentServiceClient
+}
+
+func (r *relay) CreateAppointment(ctx context.Context, req *connect.Request[pb.CreateAppointmentRequest]) (*connect.Response[pb.Appointment], error) {
+	return unary(ctx, req, r.client.CreateAppointment)
+}
+
+func (r *relay) GetAppointment(ctx context.Context, req *connect.Request[pb.GetAppointmentRequest]) (*connect.Response[pb.Appointment], error) {
+	return unary(ctx, req, r.client.GetAppointment)
+}
+
+func (r *relay) DeleteAppointment(ctx context.Context, req *connect.Request[pb.DeleteAppointmentRequest]) (*connect.Response[emptypb.Empty], error) {
+	return unary(ctx, req, r.client.DeleteAppointment)
+}
+
+func (r *relay) CancelAppointment(ctx context.Context, req *connect.Request[pb.CancelAppointmentRequest]) (*connect.Response[pb.Appointment], error) {
+	return unary(ctx, req, r.client.CancelAppointment)
+}
+
+func (r *relay) UpdateAppointmentStatus(ctx context.Context, req *connect.Request[pb.UpdateAppointmentStatusRequest]) (*connect.Response[pb.Appointment], error) {
+	return unary(ctx, req, r.client.UpdateAppointmentStatus)
+}
+
+func (r *relay) BatchCreateAppointments(ctx context.Context, req *connect.Request[pb.BatchCreateAppointmentsRequest]) (*connect.Response[pb.BatchAppointmentsResponse], error) {
+	return unary(ctx, req, r.client.BatchCreateAppointments)
+}
+
+func (r *relay) BatchDeleteAppointments(ctx context.Context, req *connect.Request[pb.BatchDeleteAppointmentsRequest]) (*connect.Response[pb.BatchAppointmentsResponse], error) {
+	return unary(ctx, req, r.client.BatchDeleteAppointments)
+}
+
+func (r *relay) RestoreAppointment(ctx context.Context, req *connect.Request[pb.RestoreAppointmentRequest]) (*connect.Response[pb.Appointment], error) {
+	return unary(ctx, req, r.client.RestoreAppointment)
+}
+
+func (r *relay) ListDeletedAppointments(ctx context.Context, req *connect.Request[pb.ListDeletedAppointmentsRequest]) (*connect.Response[pb.ListAppointmentsResponse], error) {
+	return unary(ctx, req, r.client.ListDeletedAppointments)
+}
+
+func (r *relay) PurgeAppointment(ctx context.Context, req *connect.Request[pb.PurgeAppointmentRequest]) (*connect.Response[emptypb.Empty], error) {
+	return unary(ctx, req, r.client.PurgeAppointment)
+}
+
+func (r *relay) ListAppointments(ctx context.Context, req *connect.Request[pb.ListAppointmentsRequest]) (*connect.Response[pb.ListAppointmentsResponse], error) {
+	return unary(ctx, req, r.client.ListAppointments)
+}
+
+func (r *relay) ExportICS(ctx context.Context, req *connect.Request[pb.ExportICSRequest]) (*connect.Response[pb.ExportICSResponse], error) {
+	return unary(ctx, req, r.client.ExportICS)
+}
+
+func (r *relay) ImportICS(ctx context.Context, stream *connect.ClientStream[pb.ImportICSRequest]) (*connect.Response[pb.ImportICSReport], error) {
+	return clientStream(ctx, stream, r.client.ImportICS)
+}
+
+func (r *relay) ExportAppointments(ctx context.Context, req *connect.Request[pb.ExportAppointmentsRequest], stream *connect.ServerStream[pb.ExportAppointmentsChunk]) error {
+	return serverStream(ctx, req, stream, r.client.ExportAppointments)
+}
+
+func (r *relay) ImportAppointments(ctx context.Context, stream *connect.ClientStream[pb.ImportAppointmentsRequest]) (*connect.Response[pb.ImportAppointmentsReport], error) {
+	return clientStream(ctx, stream, r.client.ImportAppointments)
+}
+
+func (r *relay) CreateFeedToken(ctx context.Context, req *connect.Request[pb.CreateFeedTokenRequest]) (*connect.Response[pb.FeedToken], error) {
+	return unary(ctx, req, r.client.CreateFeedToken)
+}
+
+func (r *relay) RotateFeedToken(ctx context.Context, req *connect.Request[pb.RotateFeedTokenRequest]) (*connect.Response[pb.FeedToken], error) {
+	return unary(ctx, req, r.client.RotateFeedToken)
+}
+
+func (r *relay) RevokeFeedToken(ctx context.Context, req *connect.Request[pb.RevokeFeedTokenRequest]) (*connect.Response[emptypb.Empty], error) {
+	return unary(ctx, req, r.client.RevokeFeedToken)
+}
+
+func (r *relay) ListFeedTokens(ctx context.Context, req *connect.Request[pb.ListFeedTokensRequest]) (*connect.Response[pb.ListFeedTokensResponse], error) {
+	return unary(ctx, req, r.client.ListFeedTokens)
+}
+
+func (r *relay) HoldSlot(ctx context.Context, req *connect.Request[pb.HoldSlotRequest]) (*connect.Response[pb.Appointment], error) {
+	return unary(ctx, req, r.client.HoldSlot)
+}
+
+func (r *relay) ConfirmHold(ctx context.Context, req *connect.Request[pb.ConfirmHoldRequest]) (*connect.Response[pb.Appointment], error) {
+	return unary(ctx, req, r.client.ConfirmHold)
+}
+
+func (r *relay) GetStatistics(ctx context.Context, req *connect.Request[pb.GetStatisticsRequest]) (*connect.Response[pb.GetStatisticsResponse], error) {
+	return unary(ctx, req, r.client.GetStatistics)
+}
+
+func (r *relay) GetCalendarSettings(ctx context.Context, req *connect.Request[pb.GetCalendarSettingsRequest]) (*connect.Response[pb.CalendarSettings], error) {
+	return unary(ctx, req, r.client.GetCalendarSettings)
+}
+
+func (r *relay) UpdateCalendarSettings(ctx context.Context, req *connect.Request[pb.UpdateCalendarSettingsRequest]) (*connect.Response[pb.CalendarSettings], error) {
+	return unary(ctx, req, r.client.UpdateCalendarSettings)
+}
+
+func (r *relay) GetAppointmentHistory(ctx context.Context, req *connect.Request[pb.GetAppointmentHistoryRequest]) (*connect.Response[pb.GetAppointmentHistoryResponse], error) {
+	return unary(ctx, req, r.client.GetAppointmentHistory)
+}
+
+func (r *relay) ListAuditEvents(ctx context.Context, req *connect.Request[pb.ListAuditEventsRequest]) (*connect.Response[pb.ListAuditEventsResponse], error) {
+	return unary(ctx, req, r.client.ListAuditEvents)
+}
+
+func (r *relay) CreateAPIKey(ctx context.Context, req *connect.Request[pb.CreateAPIKeyRequest]) (*connect.Response[pb.APIKey], error) {
+	return unary(ctx, req, r.client.CreateAPIKey)
+}
+
+func (r *relay) ListAPIKeys(ctx context.Context, req *connect.Request[pb.ListAPIKeysRequest]) (*connect.Response[pb.ListAPIKeysResponse], error) {
+	return unary(ctx, req, r.client.ListAPIKeys)
+}
+
+func (r *relay) RevokeAPIKey(ctx context.Context, req *connect.Request[pb.RevokeAPIKeyRequest]) (*connect.Response[emptypb.Empty], error) {
+	return unary(ctx, req, r.client.RevokeAPIKey)
+}
+
+func (r *relay) StreamAppointments(ctx context.Context, req *connect.Request[emptypb.Empty], stream *connect.ServerStream[pb.AppointmentStreamResponse]) error {
+	return serverStream(ctx, req, stream, r.client.StreamAppointments)
+}
@@ -0,0 +1,4 @@
+-- Group appointments into named calendars
+ALTER TABLE appointments ADD COLUMN IF NOT EXISTS calendar_id VARCHAR(100) NOT NULL DEFAULT 'default';
+
+CREATE INDEX IF NOT EXISTS idx_appointments_calendar_id ON appointments(calendar_id);
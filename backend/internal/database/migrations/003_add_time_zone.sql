@@ -0,0 +1,2 @@
+-- Record the organizer's IANA time zone so clients can render appointments in it
+ALTER TABLE appointments ADD COLUMN IF NOT EXISTS time_zone VARCHAR(64) NOT NULL DEFAULT 'UTC';
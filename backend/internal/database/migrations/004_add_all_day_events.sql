@@ -0,0 +1,30 @@
+-- All-day events span whole days in the organizer's time zone
+ALTER TABLE appointments ADD COLUMN IF NOT EXISTS all_day BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Per-calendar settings; calendars without a row use the column defaults
+CREATE TABLE IF NOT EXISTS calendar_settings (
+    calendar_id VARCHAR(100) PRIMARY KEY,
+    all_day_blocks_time BOOLEAN NOT NULL DEFAULT FALSE,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+-- All-day events only take part in conflict checks when their calendar says they block time
+CREATE OR REPLACE FUNCTION check_appointment_conflict(
+    p_start_time TIMESTAMP WITH TIME ZONE,
+    p_end_time TIMESTAMP WITH TIME ZONE,
+    p_exclude_id UUID DEFAULT NULL
+) RETURNS BOOLEAN AS $$
+BEGIN
+    RETURN EXISTS (
+        SELECT 1 FROM appointments a
+        LEFT JOIN calendar_settings cs ON cs.calendar_id = a.calendar_id
+        WHERE (p_exclude_id IS NULL OR a.id != p_exclude_id)
+        AND (NOT a.all_day OR COALESCE(cs.all_day_blocks_time, FALSE))
+        AND (
+            (a.start_time <= p_start_time AND a.end_time > p_start_time) OR
+            (a.start_time < p_end_time AND a.end_time >= p_end_time) OR
+            (a.start_time >= p_start_time AND a.end_time <= p_end_time)
+        )
+    );
+END;
+$$ LANGUAGE plpgsql;
@@ -0,0 +1,26 @@
+-- Tentative holds reserve a slot until they expire or are confirmed
+ALTER TABLE appointments ADD COLUMN IF NOT EXISTS hold_expires_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX IF NOT EXISTS idx_appointments_hold_expires_at ON appointments(hold_expires_at) WHERE hold_expires_at IS NOT NULL;
+
+-- Unexpired holds block time like regular appointments; expired ones are ignored until reaped
+CREATE OR REPLACE FUNCTION check_appointment_conflict(
+    p_start_time TIMESTAMP WITH TIME ZONE,
+    p_end_time TIMESTAMP WITH TIME ZONE,
+    p_exclude_id UUID DEFAULT NULL
+) RETURNS BOOLEAN AS $$
+BEGIN
+    RETURN EXISTS (
+        SELECT 1 FROM appointments a
+        LEFT JOIN calendar_settings cs ON cs.calendar_id = a.calendar_id
+        WHERE (p_exclude_id IS NULL OR a.id != p_exclude_id)
+        AND (NOT a.all_day OR COALESCE(cs.all_day_blocks_time, FALSE))
+        AND (a.hold_expires_at IS NULL OR a.hold_expires_at > NOW())
+        AND (
+            (a.start_time <= p_start_time AND a.end_time > p_start_time) OR
+            (a.start_time < p_end_time AND a.end_time >= p_end_time) OR
+            (a.start_time >= p_start_time AND a.end_time <= p_end_time)
+        )
+    );
+END;
+$$ LANGUAGE plpgsql;
@@ -0,0 +1,30 @@
+-- Track the appointment lifecycle instead of losing cancelled appointments
+ALTER TABLE appointments ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'scheduled'
+    CONSTRAINT valid_status CHECK (status IN ('scheduled', 'confirmed', 'cancelled', 'completed', 'no_show'));
+ALTER TABLE appointments ADD COLUMN IF NOT EXISTS cancellation_reason TEXT;
+ALTER TABLE appointments ADD COLUMN IF NOT EXISTS cancelled_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX IF NOT EXISTS idx_appointments_status ON appointments(status);
+
+-- Cancelled appointments free up their slot
+CREATE OR REPLACE FUNCTION check_appointment_conflict(
+    p_start_time TIMESTAMP WITH TIME ZONE,
+    p_end_time TIMESTAMP WITH TIME ZONE,
+    p_exclude_id UUID DEFAULT NULL
+) RETURNS BOOLEAN AS $$
+BEGIN
+    RETURN EXISTS (
+        SELECT 1 FROM appointments a
+        LEFT JOIN calendar_settings cs ON cs.calendar_id = a.calendar_id
+        WHERE (p_exclude_id IS NULL OR a.id != p_exclude_id)
+        AND a.status != 'cancelled'
+        AND (NOT a.all_day OR COALESCE(cs.all_day_blocks_time, FALSE))
+        AND (a.hold_expires_at IS NULL OR a.hold_expires_at > NOW())
+        AND (
+            (a.start_time <= p_start_time AND a.end_time > p_start_time) OR
+            (a.start_time < p_end_time AND a.end_time >= p_end_time) OR
+            (a.start_time >= p_start_time AND a.end_time <= p_end_time)
+        )
+    );
+END;
+$$ LANGUAGE plpgsql;
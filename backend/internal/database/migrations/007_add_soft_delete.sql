@@ -0,0 +1,28 @@
+-- Deleted appointments move to the trash and can be restored until purged
+ALTER TABLE appointments ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX IF NOT EXISTS idx_appointments_deleted_at ON appointments(deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- Appointments in the trash free up their slot
+CREATE OR REPLACE FUNCTION check_appointment_conflict(
+    p_start_time TIMESTAMP WITH TIME ZONE,
+    p_end_time TIMESTAMP WITH TIME ZONE,
+    p_exclude_id UUID DEFAULT NULL
+) RETURNS BOOLEAN AS $$
+BEGIN
+    RETURN EXISTS (
+        SELECT 1 FROM appointments a
+        LEFT JOIN calendar_settings cs ON cs.calendar_id = a.calendar_id
+        WHERE (p_exclude_id IS NULL OR a.id != p_exclude_id)
+        AND a.deleted_at IS NULL
+        AND a.status != 'cancelled'
+        AND (NOT a.all_day OR COALESCE(cs.all_day_blocks_time, FALSE))
+        AND (a.hold_expires_at IS NULL OR a.hold_expires_at > NOW())
+        AND (
+            (a.start_time <= p_start_time AND a.end_time > p_start_time) OR
+            (a.start_time < p_end_time AND a.end_time >= p_end_time) OR
+            (a.start_time >= p_start_time AND a.end_time <= p_end_time)
+        )
+    );
+END;
+$$ LANGUAGE plpgsql;
@@ -0,0 +1,18 @@
+-- Audit log of every appointment change, written in the same transaction as the change
+CREATE TABLE IF NOT EXISTS appointment_history (
+    id BIGSERIAL PRIMARY KEY,
+    -- No foreign key: history outlives purged appointments
+    appointment_id UUID NOT NULL,
+    action VARCHAR(20) NOT NULL,
+    actor VARCHAR(255) NOT NULL,
+    request_id VARCHAR(255) NOT NULL DEFAULT '',
+    occurred_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    before JSONB,
+    after JSONB,
+
+    CONSTRAINT valid_action CHECK (action IN ('created', 'updated', 'deleted', 'restored', 'purged'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_appointment_history_appointment_id ON appointment_history(appointment_id, occurred_at);
+CREATE INDEX IF NOT EXISTS idx_appointment_history_occurred_at ON appointment_history(occurred_at);
+CREATE INDEX IF NOT EXISTS idx_appointment_history_actor ON appointment_history(actor);
@@ -0,0 +1,4 @@
+-- UID of the iCalendar event an appointment was imported from, used to skip duplicates
+ALTER TABLE appointments ADD COLUMN IF NOT EXISTS ical_uid VARCHAR(255);
+
+CREATE INDEX IF NOT EXISTS idx_appointments_ical_uid ON appointments(ical_uid) WHERE ical_uid IS NOT NULL;
@@ -0,0 +1,11 @@
+-- Secret tokens for subscribable iCalendar feeds; only a SHA-256 hash of each token is stored
+CREATE TABLE IF NOT EXISTS feed_tokens (
+    id UUID PRIMARY KEY,
+    calendar_id VARCHAR(100) NOT NULL,
+    token_hash CHAR(64) NOT NULL UNIQUE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    rotated_at TIMESTAMP WITH TIME ZONE,
+    revoked_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_feed_tokens_calendar_id ON feed_tokens(calendar_id);
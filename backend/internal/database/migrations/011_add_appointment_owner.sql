@@ -0,0 +1,12 @@
+-- Who created each appointment and who may manage it besides the calendar's editors
+ALTER TABLE appointments ADD COLUMN IF NOT EXISTS owner VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE appointments ADD COLUMN IF NOT EXISTS created_by VARCHAR(255) NOT NULL DEFAULT '';
+
+-- Appointments created before ownership was tracked belong to whoever the audit log
+-- says created them
+UPDATE appointments a
+SET owner = h.actor, created_by = h.actor
+FROM appointment_history h
+WHERE h.appointment_id = a.id AND h.action = 'created' AND a.created_by = '';
+
+CREATE INDEX IF NOT EXISTS idx_appointments_owner ON appointments(owner);
@@ -0,0 +1,58 @@
+-- Every row belongs to a tenant; existing data moves to the default tenant. The
+-- default is dropped again so that inserts have to name their tenant.
+ALTER TABLE appointments ADD COLUMN IF NOT EXISTS tenant_id VARCHAR(100) NOT NULL DEFAULT 'default';
+ALTER TABLE appointments ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE calendar_settings ADD COLUMN IF NOT EXISTS tenant_id VARCHAR(100) NOT NULL DEFAULT 'default';
+ALTER TABLE calendar_settings ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE appointment_history ADD COLUMN IF NOT EXISTS tenant_id VARCHAR(100) NOT NULL DEFAULT 'default';
+ALTER TABLE appointment_history ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE feed_tokens ADD COLUMN IF NOT EXISTS tenant_id VARCHAR(100) NOT NULL DEFAULT 'default';
+ALTER TABLE feed_tokens ALTER COLUMN tenant_id DROP DEFAULT;
+
+-- Calendar IDs are only unique within a tenant
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM pg_constraint
+        WHERE conname = 'calendar_settings_pkey' AND array_length(conkey, 1) = 2
+    ) THEN
+        ALTER TABLE calendar_settings DROP CONSTRAINT IF EXISTS calendar_settings_pkey;
+        ALTER TABLE calendar_settings ADD CONSTRAINT calendar_settings_pkey PRIMARY KEY (tenant_id, calendar_id);
+    END IF;
+END;
+$$;
+
+CREATE INDEX IF NOT EXISTS idx_appointments_tenant_time_range ON appointments(tenant_id, start_time, end_time);
+CREATE INDEX IF NOT EXISTS idx_appointments_tenant_calendar_id ON appointments(tenant_id, calendar_id);
+CREATE INDEX IF NOT EXISTS idx_appointment_history_tenant_occurred_at ON appointment_history(tenant_id, occurred_at);
+CREATE INDEX IF NOT EXISTS idx_feed_tokens_tenant_calendar_id ON feed_tokens(tenant_id, calendar_id);
+
+-- Appointments only conflict with others of the same tenant. The tenant-blind
+-- version from the earlier migrations is dropped so it cannot be called by
+-- mistake. Like every migration this runs once, as recorded in schema_migrations.
+DROP FUNCTION IF EXISTS check_appointment_conflict(TIMESTAMP WITH TIME ZONE, TIMESTAMP WITH TIME ZONE, UUID);
+
+CREATE OR REPLACE FUNCTION check_appointment_conflict(
+    p_tenant_id VARCHAR,
+    p_start_time TIMESTAMP WITH TIME ZONE,
+    p_end_time TIMESTAMP WITH TIME ZONE,
+    p_exclude_id UUID DEFAULT NULL
+) RETURNS BOOLEAN AS $$
+BEGIN
+    RETURN EXISTS (
+        SELECT 1 FROM appointments a
+        LEFT JOIN calendar_settings cs ON cs.tenant_id = a.tenant_id AND cs.calendar_id = a.calendar_id
+        WHERE a.tenant_id = p_tenant_id
+        AND (p_exclude_id IS NULL OR a.id != p_exclude_id)
+        AND a.deleted_at IS NULL
+        AND a.status != 'cancelled'
+        AND (NOT a.all_day OR COALESCE(cs.all_day_blocks_time, FALSE))
+        AND (a.hold_expires_at IS NULL OR a.hold_expires_at > NOW())
+        AND (
+            (a.start_time <= p_start_time AND a.end_time > p_start_time) OR
+            (a.start_time < p_end_time AND a.end_time >= p_end_time) OR
+            (a.start_time >= p_start_time AND a.end_time <= p_end_time)
+        )
+    );
+END;
+$$ LANGUAGE plpgsql;
@@ -0,0 +1,38 @@
+-- Lists the appointments a time range overlaps, so conflicts can be reported to
+-- the caller. The rules are those of check_appointment_conflict, which is
+-- rebuilt on top of this function to keep the two in step.
+CREATE OR REPLACE FUNCTION find_appointment_conflicts(
+    p_tenant_id VARCHAR,
+    p_start_time TIMESTAMP WITH TIME ZONE,
+    p_end_time TIMESTAMP WITH TIME ZONE,
+    p_exclude_id UUID DEFAULT NULL
+) RETURNS TABLE (id UUID, start_time TIMESTAMP WITH TIME ZONE, end_time TIMESTAMP WITH TIME ZONE) AS $$
+    SELECT a.id, a.start_time, a.end_time
+    FROM appointments a
+    LEFT JOIN calendar_settings cs ON cs.tenant_id = a.tenant_id AND cs.calendar_id = a.calendar_id
+    WHERE a.tenant_id = p_tenant_id
+    AND (p_exclude_id IS NULL OR a.id != p_exclude_id)
+    AND a.deleted_at IS NULL
+    AND a.status != 'cancelled'
+    AND (NOT a.all_day OR COALESCE(cs.all_day_blocks_time, FALSE))
+    AND (a.hold_expires_at IS NULL OR a.hold_expires_at > NOW())
+    AND (
+        (a.start_time <= p_start_time AND a.end_time > p_start_time) OR
+        (a.start_time < p_end_time AND a.end_time >= p_end_time) OR
+        (a.start_time >= p_start_time AND a.end_time <= p_end_time)
+    )
+    ORDER BY a.start_time, a.id;
+$$ LANGUAGE sql STABLE;
+
+CREATE OR REPLACE FUNCTION check_appointment_conflict(
+    p_tenant_id VARCHAR,
+    p_start_time TIMESTAMP WITH TIME ZONE,
+    p_end_time TIMESTAMP WITH TIME ZONE,
+    p_exclude_id UUID DEFAULT NULL
+) RETURNS BOOLEAN AS $$
+BEGIN
+    RETURN EXISTS (
+        SELECT 1 FROM find_appointment_conflicts(p_tenant_id, p_start_time, p_end_time, p_exclude_id)
+    );
+END;
+$$ LANGUAGE plpgsql;
@@ -4,6 +4,9 @@ import (
 	"database/sql"
 	"fmt"
 	"os"
+	"path/filepath"
+	"sort"
+	"strings"
 
 	_ "github.com/lib/pq"
 	"github.com/pasDamola/schedule-management-system/internal/config"
@@ -37,22 +40,89 @@ func NewPostgresDB(cfg *config.DatabaseConfig) (*DB, error) {
 	return &DB{db}, nil
 }
 
+// migrationLock is the advisory lock key held while migrating, so replicas
+// starting together apply each migration once
+const migrationLock = 7264531
+
+// RunMigrations applies the migrations not yet recorded in schema_migrations in
+// file name order, each in its own transaction together with its record
 func (db *DB) RunMigrations() error {
-	migrationPath := "internal/database/migrations/001_create_appointments.sql"
-	
-	migration, err := os.ReadFile(migrationPath)
+	migrationDir := "internal/database/migrations"
+
+	entries, err := os.ReadDir(migrationDir)
+	if err != nil {
+		return fmt.Errorf("failed to read migrations directory: %v", err)
+	}
+
+	var files []string
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), ".sql") {
+			files = append(files, entry.Name())
+		}
+	}
+	sort.Strings(files)
+
+	err = db.inMigrationTx(func(tx *sql.Tx) error {
+		_, err := tx.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+			version TEXT PRIMARY KEY,
+			applied_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+		)`)
+		return err
+	})
 	if err != nil {
-		return fmt.Errorf("failed to read migration file: %v", err)
+		return fmt.Errorf("failed to create schema_migrations: %v", err)
 	}
 
-	if _, err := db.Exec(string(migration)); err != nil {
-		return fmt.Errorf("failed to run migration: %v", err)
+	applied := 0
+	for _, name := range files {
+		migration, err := os.ReadFile(filepath.Join(migrationDir, name))
+		if err != nil {
+			return fmt.Errorf("failed to read migration file %s: %v", name, err)
+		}
+
+		err = db.inMigrationTx(func(tx *sql.Tx) error {
+			var done bool
+			if err := tx.QueryRow(
+				"SELECT EXISTS (SELECT 1 FROM schema_migrations WHERE version = $1)", name,
+			).Scan(&done); err != nil || done {
+				return err
+			}
+			if _, err := tx.Exec(string(migration)); err != nil {
+				return err
+			}
+			if _, err := tx.Exec("INSERT INTO schema_migrations (version) VALUES ($1)", name); err != nil {
+				return err
+			}
+			applied++
+			logrus.WithField("migration", name).Info("Applied database migration")
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to run migration %s: %v", name, err)
+		}
 	}
 
-	logrus.Info("Database migrations completed successfully")
+	logrus.WithField("applied", applied).Info("Database migrations completed successfully")
 	return nil
 }
 
+// inMigrationTx runs fn in a transaction holding the migration lock
+func (db *DB) inMigrationTx(fn func(tx *sql.Tx) error) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("SELECT pg_advisory_xact_lock($1)", migrationLock); err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
 func (db *DB) Close() error {
 	logrus.Info("Closing database connection")
 	return db.DB.Close()
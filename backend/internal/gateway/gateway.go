@@ -0,0 +1,96 @@
+// Package gateway serves the AppointmentService as REST/JSON, translating HTTP
+// requests into calls to the gRPC server according to the google.api.http
+// annotations in appointment.proto.
+package gateway
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	grpcServer "github.com/pasDamola/schedule-management-system/internal/grpc"
+	"github.com/pasDamola/schedule-management-system/internal/openapi"
+	"github.com/pasDamola/schedule-management-system/internal/tracing"
+	pb "github.com/pasDamola/schedule-management-system/pkg/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// forwardedHeaders are passed on to the gRPC server as metadata of the same
+// name; the trace context headers are added in init
+var forwardedHeaders = map[string]bool{
+	"x-actor":      true,
+	"x-api-key":    true,
+	"x-tenant-id":  true,
+	"x-request-id": true,
+}
+
+func init() {
+	for _, key := range tracing.PropagatedHeaders {
+		forwardedHeaders[key] = true
+	}
+}
+
+// NewHandler returns a handler that proxies REST calls to the gRPC server at
+// grpcAddr, next to the OpenAPI document describing them. Calls go over a real
+// connection, so they pass through the same interceptors as any other gRPC
+// client. The connection is closed when ctx is done.
+func NewHandler(ctx context.Context, grpcAddr string) (http.Handler, error) {
+	gatewayMux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{
+				UseProtoNames:   true,
+				EmitUnpopulated: true,
+			},
+			UnmarshalOptions: protojson.UnmarshalOptions{
+				DiscardUnknown: true,
+			},
+		}),
+		runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
+		runtime.WithMetadata(clientAddress),
+	)
+
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	if err := pb.RegisterAppointmentServiceHandlerFromEndpoint(ctx, gatewayMux, grpcAddr, opts); err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/", gatewayMux)
+	openapi.Register(mux)
+	return mux, nil
+}
+
+func incomingHeaderMatcher(key string) (string, bool) {
+	if forwardedHeaders[strings.ToLower(key)] {
+		return strings.ToLower(key), true
+	}
+	// Grpc-Metadata-X-Client-Address would otherwise pass as the header
+	if name, ok := runtime.DefaultHeaderMatcher(key); ok && !strings.EqualFold(name, grpcServer.ClientAddressHeader) {
+		return name, true
+	}
+	return "", false
+}
+
+// clientAddress passes the address the request came from to the gRPC server
+func clientAddress(ctx context.Context, r *http.Request) metadata.MD {
+	host := r.RemoteAddr
+	if h, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		host = h
+	}
+	return metadata.Pairs(grpcServer.ClientAddressHeader, host)
+}
+
+// outgoingHeaderMatcher returns forwarded headers, such as the request ID, under
+// their own name instead of with the Grpc-Metadata- prefix
+func outgoingHeaderMatcher(key string) (string, bool) {
+	if forwardedHeaders[strings.ToLower(key)] {
+		return key, true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
@@ -0,0 +1,72 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/pasDamola/schedule-management-system/internal/models"
+	pb "github.com/pasDamola/schedule-management-system/pkg/pb"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *AppointmentServer) CreateAPIKey(ctx context.Context, req *pb.CreateAPIKeyRequest) (*pb.APIKey, error) {
+	logrus.WithField("name", req.Name).Info("Creating API key")
+
+	key, err := s.service.CreateAPIKey(ctx, &models.CreateAPIKeyRequest{
+		Name:   req.Name,
+		Scopes: req.Scopes,
+	})
+	if err != nil {
+		return nil, s.handleServiceError(err)
+	}
+
+	return apiKeyToProto(key), nil
+}
+
+func (s *AppointmentServer) ListAPIKeys(ctx context.Context, req *pb.ListAPIKeysRequest) (*pb.ListAPIKeysResponse, error) {
+	keys, err := s.service.ListAPIKeys(ctx)
+	if err != nil {
+		return nil, s.handleServiceError(err)
+	}
+
+	response := &pb.ListAPIKeysResponse{}
+	for i := range keys {
+		response.ApiKeys = append(response.ApiKeys, apiKeyToProto(&keys[i]))
+	}
+
+	return response, nil
+}
+
+func (s *AppointmentServer) RevokeAPIKey(ctx context.Context, req *pb.RevokeAPIKeyRequest) (*emptypb.Empty, error) {
+	logrus.WithField("id", req.Id).Info("Revoking API key")
+
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, invalidArgument("INVALID_ID", fmt.Sprintf("invalid API key ID: %v", err), "id")
+	}
+
+	if err := s.service.RevokeAPIKey(ctx, id); err != nil {
+		return nil, s.handleServiceError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func apiKeyToProto(key *models.APIKey) *pb.APIKey {
+	proto := &pb.APIKey{
+		Id:        key.ID.String(),
+		Name:      key.Name,
+		Scopes:    key.Scopes,
+		Prefix:    key.Prefix,
+		Key:       key.Key,
+		CreatedBy: key.CreatedBy,
+		CreatedAt: timestamppb.New(key.CreatedAt),
+	}
+	if key.LastUsedAt != nil {
+		proto.LastUsedAt = timestamppb.New(*key.LastUsedAt)
+	}
+	return proto
+}
@@ -0,0 +1,87 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/pasDamola/schedule-management-system/internal/models"
+	pb "github.com/pasDamola/schedule-management-system/pkg/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *AppointmentServer) GetAppointmentHistory(ctx context.Context, req *pb.GetAppointmentHistoryRequest) (*pb.GetAppointmentHistoryResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, invalidArgument("INVALID_ID", fmt.Sprintf("invalid appointment ID: %v", err), "id")
+	}
+
+	events, err := s.service.GetAppointmentHistory(ctx, id)
+	if err != nil {
+		return nil, s.handleServiceError(err)
+	}
+
+	response := &pb.GetAppointmentHistoryResponse{}
+	for i := range events {
+		response.Events = append(response.Events, s.auditEventToProto(&events[i]))
+	}
+
+	return response, nil
+}
+
+func (s *AppointmentServer) ListAuditEvents(ctx context.Context, req *pb.ListAuditEventsRequest) (*pb.ListAuditEventsResponse, error) {
+	listReq := &models.ListAuditEventsRequest{
+		Page:   int(req.Page),
+		Limit:  int(req.Limit),
+		Actor:  req.Actor,
+		Action: models.AuditAction(req.Action),
+	}
+
+	if req.AppointmentId != "" {
+		id, err := uuid.Parse(req.AppointmentId)
+		if err != nil {
+			return nil, invalidArgument("INVALID_ID", fmt.Sprintf("invalid appointment ID: %v", err), "appointment_id")
+		}
+		listReq.AppointmentID = id
+	}
+	if req.StartTime != nil {
+		listReq.StartTime = req.StartTime.AsTime()
+	}
+	if req.EndTime != nil {
+		listReq.EndTime = req.EndTime.AsTime()
+	}
+
+	response, err := s.service.ListAuditEvents(ctx, listReq)
+	if err != nil {
+		return nil, s.handleServiceError(err)
+	}
+
+	pbResponse := &pb.ListAuditEventsResponse{
+		Total: int32(response.Total),
+		Page:  int32(response.Page),
+		Limit: int32(response.Limit),
+	}
+	for i := range response.Events {
+		pbResponse.Events = append(pbResponse.Events, s.auditEventToProto(&response.Events[i]))
+	}
+
+	return pbResponse, nil
+}
+
+func (s *AppointmentServer) auditEventToProto(event *models.AuditEvent) *pb.AuditEvent {
+	proto := &pb.AuditEvent{
+		Id:            event.ID,
+		AppointmentId: event.AppointmentID.String(),
+		Action:        string(event.Action),
+		Actor:         event.Actor,
+		RequestId:     event.RequestID,
+		OccurredAt:    timestamppb.New(event.OccurredAt),
+	}
+	if event.Before != nil {
+		proto.Before = s.appointmentToProto(event.Before)
+	}
+	if event.After != nil {
+		proto.After = s.appointmentToProto(event.After)
+	}
+	return proto
+}
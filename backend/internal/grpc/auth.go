@@ -0,0 +1,147 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/pasDamola/schedule-management-system/internal/auth"
+	"github.com/pasDamola/schedule-management-system/internal/models"
+	"github.com/pasDamola/schedule-management-system/internal/requestinfo"
+	pb "github.com/pasDamola/schedule-management-system/pkg/pb"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Metadata keys carrying the caller's credentials
+const (
+	AuthorizationHeader = "authorization"
+	APIKeyHeader        = "x-api-key"
+)
+
+// APIKeyAuthenticator resolves an API key to the principal it acts as
+type APIKeyAuthenticator interface {
+	AuthenticateAPIKey(ctx context.Context, key string) (*auth.Principal, error)
+}
+
+// publicMethodPrefixes may be called without a token: probes and tooling
+// cannot be expected to hold one
+var publicMethodPrefixes = []string{
+	"/grpc.health.v1.Health/",
+	"/grpc.reflection.",
+}
+
+// readMethods only need the appointments:read scope; every other method needs
+// appointments:write
+var readMethods = map[string]bool{
+	pb.AppointmentService_GetAppointment_FullMethodName:          true,
+	pb.AppointmentService_ListAppointments_FullMethodName:        true,
+	pb.AppointmentService_ListDeletedAppointments_FullMethodName: true,
+	pb.AppointmentService_ExportICS_FullMethodName:               true,
+	pb.AppointmentService_ExportAppointments_FullMethodName:      true,
+	pb.AppointmentService_ListFeedTokens_FullMethodName:          true,
+	pb.AppointmentService_GetStatistics_FullMethodName:           true,
+	pb.AppointmentService_GetCalendarSettings_FullMethodName:     true,
+	pb.AppointmentService_GetAppointmentHistory_FullMethodName:   true,
+	pb.AppointmentService_ListAuditEvents_FullMethodName:         true,
+	pb.AppointmentService_ListAPIKeys_FullMethodName:             true,
+	pb.AppointmentService_StreamAppointments_FullMethodName:      true,
+}
+
+// requiredScope returns the scope an API key needs to call fullMethod
+func requiredScope(fullMethod string) string {
+	if readMethods[fullMethod] {
+		return models.ScopeAppointmentsRead
+	}
+	return models.ScopeAppointmentsWrite
+}
+
+// AuthUnaryInterceptor rejects calls without a valid bearer token or API key and
+// puts the caller's principal in the context. With a nil verifier bearer tokens
+// are not required, but API keys are still checked when sent.
+func AuthUnaryInterceptor(verifier *auth.Verifier, apiKeys APIKeyAuthenticator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := authenticate(ctx, verifier, apiKeys, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// AuthStreamInterceptor is the streaming counterpart of AuthUnaryInterceptor
+func AuthStreamInterceptor(verifier *auth.Verifier, apiKeys APIKeyAuthenticator) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticate(stream.Context(), verifier, apiKeys, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &requestInfoStream{ServerStream: stream, ctx: ctx})
+	}
+}
+
+// authenticate verifies the API key or else the bearer token in the incoming
+// metadata. The principal's subject and tenant replace any x-actor and
+// x-tenant-id headers, since the headers are not verified. Calls without an API
+// key pass unauthenticated when verifier is nil.
+func authenticate(ctx context.Context, verifier *auth.Verifier, apiKeys APIKeyAuthenticator, fullMethod string) (context.Context, error) {
+	for _, prefix := range publicMethodPrefixes {
+		if strings.HasPrefix(fullMethod, prefix) {
+			return ctx, nil
+		}
+	}
+
+	var header, apiKey string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(AuthorizationHeader); len(values) > 0 {
+			header = values[0]
+		}
+		if values := md.Get(APIKeyHeader); len(values) > 0 {
+			apiKey = values[0]
+		}
+	}
+
+	var principal *auth.Principal
+	if apiKey != "" {
+		var err error
+		principal, err = apiKeys.AuthenticateAPIKey(ctx, apiKey)
+		if err != nil {
+			if err == models.ErrInvalidAPIKey {
+				logrus.WithField("method", fullMethod).Debug("Rejected API key")
+				return nil, status.Error(codes.Unauthenticated, err.Error())
+			}
+			logrus.WithError(err).WithField("method", fullMethod).Error("Failed to verify API key")
+			return nil, status.Error(codes.Unavailable, "unable to verify API key")
+		}
+	} else if verifier == nil {
+		return ctx, nil
+	} else {
+		token, err := auth.BearerToken(header)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+
+		principal, err = verifier.Verify(ctx, token)
+		if err != nil {
+			if errors.Is(err, auth.ErrInvalidToken) {
+				logrus.WithError(err).WithField("method", fullMethod).Debug("Rejected bearer token")
+				return nil, status.Error(codes.Unauthenticated, auth.ErrInvalidToken.Error())
+			}
+			logrus.WithError(err).WithField("method", fullMethod).Error("Failed to verify bearer token")
+			return nil, status.Error(codes.Unavailable, "unable to verify bearer token")
+		}
+	}
+
+	if scope := requiredScope(fullMethod); !principal.HasScope(scope) {
+		return nil, status.Errorf(codes.PermissionDenied, "missing scope %s", scope)
+	}
+
+	info := requestinfo.FromContext(ctx)
+	info.Actor = principal.Subject
+	info.Tenant = principal.Tenant
+	ctx = requestinfo.NewContext(ctx, info)
+	return auth.NewContext(ctx, principal), nil
+}
@@ -0,0 +1,123 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/pasDamola/schedule-management-system/internal/models"
+	pb "github.com/pasDamola/schedule-management-system/pkg/pb"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/status"
+)
+
+var batchModeFromProto = map[pb.BatchMode]models.BatchMode{
+	pb.BatchMode_ATOMIC:      models.BatchModeAtomic,
+	pb.BatchMode_BEST_EFFORT: models.BatchModeBestEffort,
+}
+
+func (s *AppointmentServer) BatchCreateAppointments(ctx context.Context, req *pb.BatchCreateAppointmentsRequest) (*pb.BatchAppointmentsResponse, error) {
+	logrus.WithField("count", len(req.Appointments)).Info("Creating appointment batch")
+
+	mode, ok := batchModeFromProto[req.Mode]
+	if !ok {
+		return nil, invalidArgument("INVALID_BATCH_MODE", "invalid batch mode", "mode")
+	}
+
+	// Items are validated by the service so best-effort batches can report them one by one
+	createReqs := make([]*models.CreateAppointmentRequest, len(req.Appointments))
+	for i, item := range req.Appointments {
+		createReqs[i] = &models.CreateAppointmentRequest{
+			Title:      item.Title,
+			CalendarID: item.CalendarId,
+			TimeZone:   item.TimeZone,
+			AllDay:     item.AllDay,
+			StartDate:  item.StartDate,
+			EndDate:    item.EndDate,
+		}
+		if !item.AllDay && item.StartTime != nil && item.EndTime != nil {
+			createReqs[i].StartTime = item.StartTime.AsTime()
+			createReqs[i].EndTime = item.EndTime.AsTime()
+		}
+	}
+
+	results, err := s.service.BatchCreateAppointments(ctx, createReqs, mode)
+	if err != nil {
+		return nil, s.handleBatchError(err, func(index int, field string) string {
+			return fmt.Sprintf("appointments[%d].%s", index, field)
+		})
+	}
+
+	return s.batchResultsToProto(results), nil
+}
+
+func (s *AppointmentServer) BatchDeleteAppointments(ctx context.Context, req *pb.BatchDeleteAppointmentsRequest) (*pb.BatchAppointmentsResponse, error) {
+	logrus.WithField("count", len(req.Ids)).Info("Deleting appointment batch")
+
+	mode, ok := batchModeFromProto[req.Mode]
+	if !ok {
+		return nil, invalidArgument("INVALID_BATCH_MODE", "invalid batch mode", "mode")
+	}
+
+	// Unparseable IDs are left as uuid.Nil and rejected per item by the service
+	ids := make([]uuid.UUID, len(req.Ids))
+	for i, rawID := range req.Ids {
+		if id, err := uuid.Parse(rawID); err == nil {
+			ids[i] = id
+		}
+	}
+
+	results, err := s.service.BatchDeleteAppointments(ctx, ids, mode)
+	if err != nil {
+		// Items are bare IDs, so the only field of an item is the item itself
+		return nil, s.handleBatchError(err, func(index int, field string) string {
+			return fmt.Sprintf("ids[%d]", index)
+		})
+	}
+
+	return s.batchResultsToProto(results), nil
+}
+
+// handleBatchError maps a failed batch to a gRPC status, naming the item that
+// rolled back an atomic batch. itemField gives the path of an item's field in
+// the request, for field violations.
+func (s *AppointmentServer) handleBatchError(err error, itemField func(index int, field string) string) error {
+	var itemErr *models.BatchItemError
+	if !errors.As(err, &itemErr) {
+		return s.handleServiceError(err)
+	}
+
+	itemStatus := serviceStatus(itemErr.Err, func(field string) string {
+		return itemField(itemErr.Index, field)
+	}).Proto()
+	itemStatus.Message = fmt.Sprintf("item %d: %s", itemErr.Index, itemStatus.Message)
+	return status.ErrorProto(itemStatus)
+}
+
+func (s *AppointmentServer) batchResultsToProto(results []models.BatchItemResult) *pb.BatchAppointmentsResponse {
+	response := &pb.BatchAppointmentsResponse{}
+	for _, result := range results {
+		item := &pb.BatchItemResult{
+			Index: int32(result.Index),
+		}
+		if result.ID != uuid.Nil {
+			item.Id = result.ID.String()
+		}
+
+		if result.Err != nil {
+			itemStatus := status.Convert(s.handleServiceError(result.Err))
+			item.Code = int32(itemStatus.Code())
+			item.Error = itemStatus.Message()
+			response.Failed++
+		} else {
+			if result.Appointment != nil {
+				item.Appointment = s.appointmentToProto(result.Appointment)
+			}
+			response.Succeeded++
+		}
+
+		response.Results = append(response.Results, item)
+	}
+	return response
+}
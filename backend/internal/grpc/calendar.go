@@ -0,0 +1,41 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/pasDamola/schedule-management-system/internal/models"
+	pb "github.com/pasDamola/schedule-management-system/pkg/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *AppointmentServer) GetCalendarSettings(ctx context.Context, req *pb.GetCalendarSettingsRequest) (*pb.CalendarSettings, error) {
+	settings, err := s.service.GetCalendarSettings(ctx, req.CalendarId)
+	if err != nil {
+		return nil, s.handleServiceError(err)
+	}
+
+	return calendarSettingsToProto(settings), nil
+}
+
+func (s *AppointmentServer) UpdateCalendarSettings(ctx context.Context, req *pb.UpdateCalendarSettingsRequest) (*pb.CalendarSettings, error) {
+	settings, err := s.service.UpdateCalendarSettings(ctx, &models.UpdateCalendarSettingsRequest{
+		CalendarID:       req.CalendarId,
+		AllDayBlocksTime: req.AllDayBlocksTime,
+	})
+	if err != nil {
+		return nil, s.handleServiceError(err)
+	}
+
+	return calendarSettingsToProto(settings), nil
+}
+
+func calendarSettingsToProto(settings *models.CalendarSettings) *pb.CalendarSettings {
+	proto := &pb.CalendarSettings{
+		CalendarId:       settings.CalendarID,
+		AllDayBlocksTime: settings.AllDayBlocksTime,
+	}
+	if !settings.UpdatedAt.IsZero() {
+		proto.UpdatedAt = timestamppb.New(settings.UpdatedAt)
+	}
+	return proto
+}
@@ -0,0 +1,175 @@
+package grpc
+
+import (
+	"errors"
+
+	"github.com/pasDamola/schedule-management-system/internal/models"
+	pb "github.com/pasDamola/schedule-management-system/pkg/pb"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// errorDomain is the domain of the reason codes in ErrorInfo details
+var errorDomain = pb.AppointmentService_ServiceDesc.ServiceName
+
+// serviceError describes how a service error is reported: its code and
+// message, a stable reason code for clients to match on, and for validation
+// errors the request fields at fault
+type serviceError struct {
+	err     error
+	code    codes.Code
+	reason  string
+	message string
+	fields  []string
+}
+
+// serviceErrors are matched in order with errors.Is, so wrapped errors map like
+// the errors they wrap
+var serviceErrors = []serviceError{
+	{models.ErrAppointmentNotFound, codes.NotFound, "APPOINTMENT_NOT_FOUND",
+		"appointment not found", nil},
+	{models.ErrAppointmentConflict, codes.AlreadyExists, "APPOINTMENT_CONFLICT",
+		"appointment time conflicts with existing appointment", nil},
+	{models.ErrInvalidTitle, codes.InvalidArgument, "INVALID_TITLE",
+		"invalid title: title cannot be empty", []string{"title"}},
+	{models.ErrInvalidTime, codes.InvalidArgument, "INVALID_TIME",
+		"invalid time: start time and end time are required", []string{"start_time", "end_time"}},
+	{models.ErrInvalidTimeRange, codes.InvalidArgument, "INVALID_TIME_RANGE",
+		"invalid time range: start time must be before end time and appointments must be 15 minutes to 8 hours long", []string{"start_time", "end_time"}},
+	{models.ErrInvalidID, codes.InvalidArgument, "INVALID_ID",
+		"invalid ID: ID cannot be empty", []string{"id"}},
+	{models.ErrPastTime, codes.InvalidArgument, "PAST_TIME",
+		"invalid time: cannot schedule appointments in the past", []string{"start_time"}},
+	{models.ErrInvalidCalendarID, codes.InvalidArgument, "INVALID_CALENDAR_ID",
+		"invalid calendar ID: calendar ID must be at most 100 characters", []string{"calendar_id"}},
+	{models.ErrInvalidTimeZone, codes.InvalidArgument, "INVALID_TIME_ZONE",
+		"invalid time zone: must be an IANA time zone name such as Europe/Berlin", []string{"time_zone"}},
+	{models.ErrInvalidDate, codes.InvalidArgument, "INVALID_DATE",
+		"invalid date: all-day events need start and end dates in YYYY-MM-DD format with start not after end", []string{"start_date", "end_date"}},
+	{models.ErrNotAHold, codes.FailedPrecondition, "NOT_A_HOLD",
+		"appointment is not a tentative hold", nil},
+	{models.ErrHoldExpired, codes.FailedPrecondition, "HOLD_EXPIRED",
+		"hold has expired", nil},
+	{models.ErrInvalidHoldTTL, codes.InvalidArgument, "INVALID_HOLD_TTL",
+		"invalid hold TTL: exceeds the maximum hold duration", []string{"ttl"}},
+	{models.ErrInvalidStatus, codes.InvalidArgument, "INVALID_STATUS",
+		"invalid status: must be one of scheduled, confirmed, cancelled, completed or no_show", []string{"status"}},
+	{models.ErrInvalidStatusTransition, codes.FailedPrecondition, "INVALID_STATUS_TRANSITION",
+		"invalid status transition", nil},
+	{models.ErrInvalidCancelReason, codes.InvalidArgument, "INVALID_CANCEL_REASON",
+		"invalid cancellation reason: reason must be at most 1000 characters", []string{"reason"}},
+	{models.ErrInvalidStatisticsWindow, codes.InvalidArgument, "INVALID_STATISTICS_WINDOW",
+		"invalid statistics window: start date must be before end date and at most 366 days apart", []string{"start_date", "end_date"}},
+	{models.ErrInvalidGroupBy, codes.InvalidArgument, "INVALID_GROUP_BY",
+		"invalid group by: must be one of none, day, week or calendar", []string{"group_by"}},
+	{models.ErrInvalidBatchSize, codes.InvalidArgument, "INVALID_BATCH_SIZE",
+		"invalid batch size: must contain between 1 and 500 items", nil},
+	{models.ErrExportTooLarge, codes.InvalidArgument, "EXPORT_TOO_LARGE",
+		"export too large: narrow the filter to at most 5000 appointments", nil},
+	{models.ErrInvalidICS, codes.InvalidArgument, "INVALID_ICS",
+		"invalid iCalendar data", nil},
+	{models.ErrImportTooLarge, codes.ResourceExhausted, "IMPORT_TOO_LARGE",
+		"import too large: uploads are limited to 10 MiB", nil},
+	{models.ErrInvalidICalUID, codes.InvalidArgument, "INVALID_ICAL_UID",
+		"invalid iCalendar UID: UID must be at most 255 characters", nil},
+	{models.ErrImportOptions, codes.InvalidArgument, "IMPORT_OPTIONS_NOT_FIRST",
+		"invalid import: options must be sent before the iCalendar data", []string{"options"}},
+	{models.ErrUnsupportedICal, codes.InvalidArgument, "UNSUPPORTED_ICAL",
+		"unsupported event: recurring and cancelled events are not imported", nil},
+	{models.ErrInvalidRecordFormat, codes.InvalidArgument, "INVALID_RECORD_FORMAT",
+		"invalid format: must be csv or ndjson", []string{"format"}},
+	{models.ErrInvalidCSVHeader, codes.InvalidArgument, "INVALID_CSV_HEADER",
+		"invalid CSV header: the first row must name the columns and include title", nil},
+	{models.ErrFeedNotFound, codes.NotFound, "FEED_NOT_FOUND",
+		"feed not found", nil},
+	{models.ErrInvalidAuditAction, codes.InvalidArgument, "INVALID_AUDIT_ACTION",
+		"invalid audit action: must be one of created, updated, deleted, restored or purged", []string{"action"}},
+	{models.ErrPermissionDenied, codes.PermissionDenied, "PERMISSION_DENIED",
+		"permission denied", nil},
+	{models.ErrAPIKeyNotFound, codes.NotFound, "API_KEY_NOT_FOUND",
+		"API key not found", nil},
+	{models.ErrInvalidAPIKeyName, codes.InvalidArgument, "INVALID_API_KEY_NAME",
+		"invalid API key name: name is required and must be at most 100 characters", []string{"name"}},
+	{models.ErrInvalidScope, codes.InvalidArgument, "INVALID_SCOPE",
+		"invalid scope: scopes must be appointments:read or appointments:write", []string{"scopes"}},
+	{models.ErrInvalidAPIKey, codes.Unauthenticated, "INVALID_API_KEY",
+		"invalid API key", nil},
+}
+
+// serviceStatus maps err to the status of the first matching serviceError.
+// field turns a request field into its path, such as for a batch item.
+func serviceStatus(err error, field func(string) string) *status.Status {
+	for _, e := range serviceErrors {
+		if errors.Is(err, e.err) {
+			return e.status(err, field)
+		}
+	}
+
+	logrus.WithError(err).Error("Unexpected service error")
+	return newStatus(codes.Internal, "internal server error", errorInfo("INTERNAL"))
+}
+
+// status carries an ErrorInfo, a BadRequest for validation errors and
+// ConflictDetails for conflicts
+func (e serviceError) status(err error, field func(string) string) *status.Status {
+	details := []protoadapt.MessageV1{errorInfo(e.reason)}
+	if len(e.fields) > 0 {
+		badRequest := &errdetails.BadRequest{}
+		for _, name := range e.fields {
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       field(name),
+				Description: e.message,
+				Reason:      e.reason,
+			})
+		}
+		details = append(details, badRequest)
+	}
+
+	var conflictErr *models.ConflictError
+	if errors.As(err, &conflictErr) {
+		details = append(details, conflictDetailsToProto(conflictErr))
+	}
+
+	return newStatus(e.code, e.message, details...)
+}
+
+// invalidArgument reports request fields the handler could not parse
+func invalidArgument(reason, message string, fields ...string) error {
+	e := serviceError{code: codes.InvalidArgument, reason: reason, message: message, fields: fields}
+	return e.status(nil, requestField).Err()
+}
+
+// requestField names a field of the request itself
+func requestField(name string) string {
+	return name
+}
+
+func errorInfo(reason string) *errdetails.ErrorInfo {
+	return &errdetails.ErrorInfo{Reason: reason, Domain: errorDomain}
+}
+
+// newStatus returns a status with details, or without them should they fail to
+// marshal
+func newStatus(code codes.Code, message string, details ...protoadapt.MessageV1) *status.Status {
+	st := status.New(code, message)
+	if withDetails, err := st.WithDetails(details...); err == nil {
+		return withDetails
+	}
+	return st
+}
+
+func conflictDetailsToProto(err *models.ConflictError) *pb.ConflictDetails {
+	details := &pb.ConflictDetails{}
+	for _, conflict := range err.Conflicts {
+		details.Conflicts = append(details.Conflicts, &pb.ConflictingAppointment{
+			Id:        conflict.ID.String(),
+			StartTime: timestamppb.New(conflict.StartTime),
+			EndTime:   timestamppb.New(conflict.EndTime),
+		})
+	}
+	return details
+}
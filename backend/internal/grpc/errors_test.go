@@ -0,0 +1,170 @@
+package grpc
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pasDamola/schedule-management-system/internal/models"
+	pb "github.com/pasDamola/schedule-management-system/pkg/pb"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func init() {
+	logrus.SetLevel(logrus.PanicLevel)
+}
+
+// statusDetails splits the details of st by type
+func statusDetails(t *testing.T, st *status.Status) (*errdetails.ErrorInfo, *errdetails.BadRequest, *pb.ConflictDetails) {
+	t.Helper()
+	var info *errdetails.ErrorInfo
+	var badRequest *errdetails.BadRequest
+	var conflicts *pb.ConflictDetails
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.ErrorInfo:
+			info = d
+		case *errdetails.BadRequest:
+			badRequest = d
+		case *pb.ConflictDetails:
+			conflicts = d
+		default:
+			t.Errorf("unexpected detail %T", detail)
+		}
+	}
+	return info, badRequest, conflicts
+}
+
+func TestServiceStatus(t *testing.T) {
+	conflictID := uuid.New()
+	start := time.Date(2025, 3, 3, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		err           error
+		code          codes.Code
+		reason        string
+		fields        []string
+		wantConflicts int
+	}{
+		{name: "not found", err: models.ErrAppointmentNotFound, code: codes.NotFound, reason: "APPOINTMENT_NOT_FOUND"},
+		{name: "validation", err: models.ErrInvalidTimeRange, code: codes.InvalidArgument, reason: "INVALID_TIME_RANGE", fields: []string{"start_time", "end_time"}},
+		{name: "wrapped", err: fmt.Errorf("create: %w", models.ErrPastTime), code: codes.InvalidArgument, reason: "PAST_TIME", fields: []string{"start_time"}},
+		{name: "unsupported event", err: models.ErrUnsupportedICal, code: codes.InvalidArgument, reason: "UNSUPPORTED_ICAL"},
+		{name: "permission denied", err: models.ErrPermissionDenied, code: codes.PermissionDenied, reason: "PERMISSION_DENIED"},
+		{name: "plain conflict", err: models.ErrAppointmentConflict, code: codes.AlreadyExists, reason: "APPOINTMENT_CONFLICT"},
+		{
+			name: "conflict with details",
+			err: &models.ConflictError{Conflicts: []models.Conflict{
+				{ID: conflictID, StartTime: start, EndTime: start.Add(time.Hour)},
+			}},
+			code:          codes.AlreadyExists,
+			reason:        "APPOINTMENT_CONFLICT",
+			wantConflicts: 1,
+		},
+		{name: "unexpected", err: errors.New("connection reset"), code: codes.Internal, reason: "INTERNAL"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := serviceStatus(tt.err, requestField)
+			if st.Code() != tt.code {
+				t.Errorf("code = %s, want %s", st.Code(), tt.code)
+			}
+
+			info, badRequest, conflicts := statusDetails(t, st)
+			if info == nil || info.Reason != tt.reason || info.Domain != errorDomain {
+				t.Errorf("ErrorInfo = %v, want reason %s in %s", info, tt.reason, errorDomain)
+			}
+
+			var fields []string
+			if badRequest != nil {
+				for _, violation := range badRequest.FieldViolations {
+					fields = append(fields, violation.Field)
+				}
+			}
+			if fmt.Sprint(fields) != fmt.Sprint(tt.fields) {
+				t.Errorf("field violations = %v, want %v", fields, tt.fields)
+			}
+
+			got := 0
+			if conflicts != nil {
+				got = len(conflicts.Conflicts)
+				if conflicts.Conflicts[0].Id != conflictID.String() {
+					t.Errorf("conflict ID = %s, want %s", conflicts.Conflicts[0].Id, conflictID)
+				}
+			}
+			if got != tt.wantConflicts {
+				t.Errorf("conflicts = %d, want %d", got, tt.wantConflicts)
+			}
+		})
+	}
+}
+
+func TestHandleBatchError(t *testing.T) {
+	itemField := func(index int, field string) string {
+		return fmt.Sprintf("appointments[%d].%s", index, field)
+	}
+
+	tests := []struct {
+		name    string
+		err     error
+		code    codes.Code
+		message string
+		fields  []string
+	}{
+		{
+			name:    "item error",
+			err:     &models.BatchItemError{Index: 2, Err: models.ErrInvalidTitle},
+			code:    codes.InvalidArgument,
+			message: "item 2: invalid title: title cannot be empty",
+			fields:  []string{"appointments[2].title"},
+		},
+		{
+			name:    "batch error",
+			err:     models.ErrInvalidBatchSize,
+			code:    codes.InvalidArgument,
+			message: "invalid batch size: must contain between 1 and 500 items",
+		},
+	}
+
+	s := &AppointmentServer{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := status.Convert(s.handleBatchError(tt.err, itemField))
+			if st.Code() != tt.code || st.Message() != tt.message {
+				t.Errorf("status = %s %q, want %s %q", st.Code(), st.Message(), tt.code, tt.message)
+			}
+
+			_, badRequest, _ := statusDetails(t, st)
+			var fields []string
+			if badRequest != nil {
+				for _, violation := range badRequest.FieldViolations {
+					fields = append(fields, violation.Field)
+				}
+			}
+			if fmt.Sprint(fields) != fmt.Sprint(tt.fields) {
+				t.Errorf("field violations = %v, want %v", fields, tt.fields)
+			}
+		})
+	}
+}
+
+func TestInvalidArgument(t *testing.T) {
+	st := status.Convert(invalidArgument("INVALID_START_DATE", "invalid start date", "start_date"))
+	if st.Code() != codes.InvalidArgument || st.Message() != "invalid start date" {
+		t.Errorf("status = %s %q", st.Code(), st.Message())
+	}
+	info, badRequest, _ := statusDetails(t, st)
+	if info == nil || info.Reason != "INVALID_START_DATE" {
+		t.Errorf("ErrorInfo = %v", info)
+	}
+	if badRequest == nil || len(badRequest.FieldViolations) != 1 || badRequest.FieldViolations[0].Field != "start_date" {
+		t.Errorf("BadRequest = %v", badRequest)
+	}
+}
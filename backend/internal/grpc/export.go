@@ -0,0 +1,48 @@
+package grpc
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pasDamola/schedule-management-system/internal/icalendar"
+	"github.com/pasDamola/schedule-management-system/internal/models"
+	pb "github.com/pasDamola/schedule-management-system/pkg/pb"
+	"github.com/sirupsen/logrus"
+)
+
+func (s *AppointmentServer) ExportICS(ctx context.Context, req *pb.ExportICSRequest) (*pb.ExportICSResponse, error) {
+	exportReq := &models.ListAppointmentsRequest{
+		Search:     req.Search,
+		CalendarID: req.CalendarId,
+	}
+
+	if req.StartDate != nil {
+		exportReq.StartDate = req.StartDate.AsTime()
+	}
+	if req.EndDate != nil {
+		exportReq.EndDate = req.EndDate.AsTime()
+	}
+	for _, protoStatus := range req.Statuses {
+		exportStatus, ok := statusFromProto[protoStatus]
+		if !ok {
+			return nil, s.handleServiceError(models.ErrInvalidStatus)
+		}
+		exportReq.Statuses = append(exportReq.Statuses, exportStatus)
+	}
+
+	appointments, err := s.service.ExportAppointments(ctx, exportReq)
+	if err != nil {
+		return nil, s.handleServiceError(err)
+	}
+
+	var buf bytes.Buffer
+	if err := icalendar.Encode(&buf, exportReq.CalendarID, appointments); err != nil {
+		logrus.WithError(err).Error("Failed to encode iCalendar export")
+		return nil, s.handleServiceError(err)
+	}
+
+	return &pb.ExportICSResponse{
+		Ics:        buf.Bytes(),
+		EventCount: int32(len(appointments)),
+	}, nil
+}
@@ -0,0 +1,83 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/pasDamola/schedule-management-system/internal/models"
+	pb "github.com/pasDamola/schedule-management-system/pkg/pb"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *AppointmentServer) CreateFeedToken(ctx context.Context, req *pb.CreateFeedTokenRequest) (*pb.FeedToken, error) {
+	logrus.WithField("calendar_id", req.CalendarId).Info("Creating feed token")
+
+	token, err := s.service.CreateFeedToken(ctx, req.CalendarId)
+	if err != nil {
+		return nil, s.handleServiceError(err)
+	}
+
+	return feedTokenToProto(token), nil
+}
+
+func (s *AppointmentServer) RotateFeedToken(ctx context.Context, req *pb.RotateFeedTokenRequest) (*pb.FeedToken, error) {
+	logrus.WithField("id", req.Id).Info("Rotating feed token")
+
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, invalidArgument("INVALID_ID", fmt.Sprintf("invalid feed token ID: %v", err), "id")
+	}
+
+	token, err := s.service.RotateFeedToken(ctx, id)
+	if err != nil {
+		return nil, s.handleServiceError(err)
+	}
+
+	return feedTokenToProto(token), nil
+}
+
+func (s *AppointmentServer) RevokeFeedToken(ctx context.Context, req *pb.RevokeFeedTokenRequest) (*emptypb.Empty, error) {
+	logrus.WithField("id", req.Id).Info("Revoking feed token")
+
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, invalidArgument("INVALID_ID", fmt.Sprintf("invalid feed token ID: %v", err), "id")
+	}
+
+	if err := s.service.RevokeFeedToken(ctx, id); err != nil {
+		return nil, s.handleServiceError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AppointmentServer) ListFeedTokens(ctx context.Context, req *pb.ListFeedTokensRequest) (*pb.ListFeedTokensResponse, error) {
+	tokens, err := s.service.ListFeedTokens(ctx, req.CalendarId)
+	if err != nil {
+		return nil, s.handleServiceError(err)
+	}
+
+	response := &pb.ListFeedTokensResponse{}
+	for i := range tokens {
+		response.FeedTokens = append(response.FeedTokens, feedTokenToProto(&tokens[i]))
+	}
+
+	return response, nil
+}
+
+func feedTokenToProto(token *models.FeedToken) *pb.FeedToken {
+	proto := &pb.FeedToken{
+		Id:         token.ID.String(),
+		CalendarId: token.CalendarID,
+		Token:      token.Token,
+		Url:        token.URL,
+		CreatedAt:  timestamppb.New(token.CreatedAt),
+	}
+	if token.RotatedAt != nil {
+		proto.RotatedAt = timestamppb.New(*token.RotatedAt)
+	}
+	return proto
+}
@@ -0,0 +1,59 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/pasDamola/schedule-management-system/internal/models"
+	pb "github.com/pasDamola/schedule-management-system/pkg/pb"
+	"github.com/sirupsen/logrus"
+)
+
+func (s *AppointmentServer) HoldSlot(ctx context.Context, req *pb.HoldSlotRequest) (*pb.Appointment, error) {
+	logrus.WithField("title", req.Title).Info("Holding slot")
+
+	if req.StartTime == nil || req.EndTime == nil {
+		return nil, invalidArgument("INVALID_TIME", "start_time and end_time are required", "start_time", "end_time")
+	}
+
+	holdReq := &models.HoldSlotRequest{
+		Title:      req.Title,
+		StartTime:  req.StartTime.AsTime(),
+		EndTime:    req.EndTime.AsTime(),
+		CalendarID: req.CalendarId,
+		TimeZone:   req.TimeZone,
+	}
+	if req.Ttl != nil {
+		if err := req.Ttl.CheckValid(); err != nil {
+			return nil, invalidArgument("INVALID_HOLD_TTL", fmt.Sprintf("invalid ttl: %v", err), "ttl")
+		}
+		holdReq.TTL = req.Ttl.AsDuration()
+	}
+
+	hold, err := s.service.HoldSlot(ctx, holdReq)
+	if err != nil {
+		return nil, s.handleServiceError(err)
+	}
+
+	return s.appointmentToProto(hold), nil
+}
+
+func (s *AppointmentServer) ConfirmHold(ctx context.Context, req *pb.ConfirmHoldRequest) (*pb.Appointment, error) {
+	logrus.WithField("id", req.Id).Info("Confirming hold")
+
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, invalidArgument("INVALID_ID", fmt.Sprintf("invalid appointment ID: %v", err), "id")
+	}
+
+	appointment, err := s.service.ConfirmHold(ctx, &models.ConfirmHoldRequest{
+		ID:    id,
+		Title: req.Title,
+	})
+	if err != nil {
+		return nil, s.handleServiceError(err)
+	}
+
+	return s.appointmentToProto(appointment), nil
+}
@@ -0,0 +1,102 @@
+package grpc
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/google/uuid"
+	"github.com/pasDamola/schedule-management-system/internal/models"
+	pb "github.com/pasDamola/schedule-management-system/pkg/pb"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var importOutcomeToProto = map[models.ImportOutcome]pb.ImportedEvent_Outcome{
+	models.ImportOutcomeImported:  pb.ImportedEvent_IMPORTED,
+	models.ImportOutcomeDuplicate: pb.ImportedEvent_DUPLICATE,
+	models.ImportOutcomeConflict:  pb.ImportedEvent_CONFLICT,
+	models.ImportOutcomeFailed:    pb.ImportedEvent_FAILED,
+}
+
+func (s *AppointmentServer) ImportICS(stream pb.AppointmentService_ImportICSServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		if err == io.EOF {
+			return s.handleServiceError(models.ErrImportOptions)
+		}
+		return err
+	}
+	protoOpts := first.GetOptions()
+	if protoOpts == nil {
+		return s.handleServiceError(models.ErrImportOptions)
+	}
+
+	opts := &models.ImportOptions{
+		CalendarID: protoOpts.CalendarId,
+		TimeZone:   protoOpts.TimeZone,
+		DryRun:     protoOpts.DryRun,
+	}
+	logrus.WithFields(logrus.Fields{
+		"calendar_id": opts.CalendarID,
+		"dry_run":     opts.DryRun,
+	}).Info("Importing iCalendar data")
+
+	var data bytes.Buffer
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if req.GetOptions() != nil {
+			return s.handleServiceError(models.ErrImportOptions)
+		}
+		if data.Len()+len(req.GetChunk()) > models.MaxImportSize {
+			return s.handleServiceError(models.ErrImportTooLarge)
+		}
+		data.Write(req.GetChunk())
+	}
+
+	report, err := s.service.ImportICS(stream.Context(), &data, opts)
+	if err != nil {
+		return s.handleServiceError(err)
+	}
+
+	return stream.SendAndClose(s.importReportToProto(report))
+}
+
+func (s *AppointmentServer) importReportToProto(report *models.ImportReport) *pb.ImportICSReport {
+	proto := &pb.ImportICSReport{
+		DryRun:     report.DryRun,
+		Imported:   int32(report.Count(models.ImportOutcomeImported)),
+		Duplicates: int32(report.Count(models.ImportOutcomeDuplicate)),
+		Conflicts:  int32(report.Count(models.ImportOutcomeConflict)),
+		Failed:     int32(report.Count(models.ImportOutcomeFailed)),
+	}
+
+	for _, result := range report.Results {
+		event := &pb.ImportedEvent{
+			Uid:     result.UID,
+			Title:   result.Title,
+			Outcome: importOutcomeToProto[result.Outcome],
+		}
+		if !result.StartTime.IsZero() {
+			event.StartTime = timestamppb.New(result.StartTime)
+		}
+		if !result.EndTime.IsZero() {
+			event.EndTime = timestamppb.New(result.EndTime)
+		}
+		if result.AppointmentID != uuid.Nil {
+			event.AppointmentId = result.AppointmentID.String()
+		}
+		if result.Err != nil {
+			event.Error = status.Convert(s.handleServiceError(result.Err)).Message()
+		}
+		proto.Events = append(proto.Events, event)
+	}
+
+	return proto
+}
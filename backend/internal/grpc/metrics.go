@@ -0,0 +1,58 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pasDamola/schedule-management-system/internal/metrics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// MetricsUnaryInterceptor records the latency and status code of every unary RPC
+func MetricsUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		observeRPC(info.FullMethod, "unary", start, err)
+		return resp, err
+	}
+}
+
+// MetricsStreamInterceptor is the streaming counterpart of MetricsUnaryInterceptor.
+// Streams are observed when they end, so long-lived ones land in the top bucket.
+func MetricsStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, stream)
+		observeRPC(info.FullMethod, streamType(info), start, err)
+		return err
+	}
+}
+
+func observeRPC(fullMethod, rpcType string, start time.Time, err error) {
+	service, method := splitMethod(fullMethod)
+	metrics.RPCDuration.WithLabelValues(service, method, rpcType).Observe(time.Since(start).Seconds())
+	metrics.RPCHandled.WithLabelValues(service, method, rpcType, status.Code(err).String()).Inc()
+}
+
+// splitMethod turns "/package.Service/Method" into its service and method
+func splitMethod(fullMethod string) (string, string) {
+	service, method, ok := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	if !ok {
+		return "unknown", "unknown"
+	}
+	return service, method
+}
+
+func streamType(info *grpc.StreamServerInfo) string {
+	switch {
+	case info.IsClientStream && info.IsServerStream:
+		return "bidi_stream"
+	case info.IsClientStream:
+		return "client_stream"
+	default:
+		return "server_stream"
+	}
+}
@@ -0,0 +1,113 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/pasDamola/schedule-management-system/internal/auth"
+	"github.com/pasDamola/schedule-management-system/internal/ratelimit"
+	pb "github.com/pasDamola/schedule-management-system/pkg/pb"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// ClientAddressHeader carries the address of the HTTP client on whose behalf the
+// REST gateway and the Connect API call the server. Both run in-process and call
+// over loopback, so the header is only trusted from loopback peers. That is only
+// safe while no other process on the host can reach the gRPC port: any local
+// caller could otherwise pick the address it is rate limited as.
+const ClientAddressHeader = "x-client-address"
+
+// RateLimitUnaryInterceptor refuses calls beyond the caller's rate limit with
+// ResourceExhausted, telling the caller when to retry
+func RateLimitUnaryInterceptor(limiter *ratelimit.Limiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := checkRateLimit(ctx, limiter, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// RateLimitStreamInterceptor is the streaming counterpart of
+// RateLimitUnaryInterceptor. It also caps how many StreamAppointments calls
+// each caller may hold open.
+func RateLimitStreamInterceptor(limiter *ratelimit.Limiter) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := stream.Context()
+		if err := checkRateLimit(ctx, limiter, info.FullMethod); err != nil {
+			return err
+		}
+
+		if info.FullMethod == pb.AppointmentService_StreamAppointments_FullMethodName {
+			release, ok := limiter.AcquireStream(rateLimitClient(ctx))
+			if !ok {
+				message := fmt.Sprintf("too many streams: at most %d StreamAppointments calls may be open at once", limiter.MaxStreams())
+				return newStatus(codes.ResourceExhausted, message, errorInfo("TOO_MANY_STREAMS")).Err()
+			}
+			defer release()
+		}
+
+		return handler(srv, stream)
+	}
+}
+
+func checkRateLimit(ctx context.Context, limiter *ratelimit.Limiter, fullMethod string) error {
+	for _, prefix := range publicMethodPrefixes {
+		if strings.HasPrefix(fullMethod, prefix) {
+			return nil
+		}
+	}
+
+	client := rateLimitClient(ctx)
+	_, method := splitMethod(fullMethod)
+	limit, ok, wait := limiter.Allow(client, method)
+	if ok {
+		return nil
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"client": client,
+		"method": fullMethod,
+	}).Debug("Rate limit exceeded")
+
+	return newStatus(codes.ResourceExhausted, "rate limit exceeded",
+		errorInfo("RATE_LIMIT_EXCEEDED"),
+		&errdetails.RetryInfo{RetryDelay: durationpb.New(wait)},
+		&errdetails.QuotaFailure{Violations: []*errdetails.QuotaFailure_Violation{{
+			Subject:     method,
+			Description: fmt.Sprintf("at most %g calls per second, in bursts of up to %d", limit.Rate, limit.Burst),
+		}}},
+	).Err()
+}
+
+// rateLimitClient identifies the caller as its principal, which for API keys
+// names the key, or else as the host it connects from. Calls relayed over
+// loopback are told apart by the HTTP client they were made for.
+func rateLimitClient(ctx context.Context) string {
+	if principal, ok := auth.FromContext(ctx); ok {
+		return "principal:" + principal.Tenant + "/" + principal.Subject
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		addr := p.Addr.String()
+		if host, _, err := net.SplitHostPort(addr); err == nil {
+			addr = host
+		}
+		if ip := net.ParseIP(addr); ip != nil && ip.IsLoopback() {
+			if md, ok := metadata.FromIncomingContext(ctx); ok {
+				if values := md.Get(ClientAddressHeader); len(values) > 0 && values[0] != "" {
+					addr = values[0]
+				}
+			}
+		}
+		return "peer:" + addr
+	}
+	return "unknown"
+}
@@ -0,0 +1,182 @@
+package grpc
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+
+	"github.com/google/uuid"
+	"github.com/pasDamola/schedule-management-system/internal/models"
+	"github.com/pasDamola/schedule-management-system/internal/records"
+	pb "github.com/pasDamola/schedule-management-system/pkg/pb"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// exportChunkSize is the most file data sent in one ExportAppointmentsChunk
+const exportChunkSize = 64 << 10
+
+var recordFormatFromProto = map[pb.RecordFormat]models.RecordFormat{
+	pb.RecordFormat_CSV:    models.RecordFormatCSV,
+	pb.RecordFormat_NDJSON: models.RecordFormatNDJSON,
+}
+
+var batchModeToProto = map[models.BatchMode]pb.BatchMode{
+	models.BatchModeAtomic:     pb.BatchMode_ATOMIC,
+	models.BatchModeBestEffort: pb.BatchMode_BEST_EFFORT,
+}
+
+func (s *AppointmentServer) ExportAppointments(req *pb.ExportAppointmentsRequest, stream pb.AppointmentService_ExportAppointmentsServer) error {
+	format, ok := recordFormatFromProto[req.Format]
+	if !ok {
+		return s.handleServiceError(models.ErrInvalidRecordFormat)
+	}
+
+	exportReq := &models.ListAppointmentsRequest{
+		Search:     req.Search,
+		CalendarID: req.CalendarId,
+	}
+
+	if req.StartDate != nil {
+		exportReq.StartDate = req.StartDate.AsTime()
+	}
+	if req.EndDate != nil {
+		exportReq.EndDate = req.EndDate.AsTime()
+	}
+	for _, protoStatus := range req.Statuses {
+		exportStatus, ok := statusFromProto[protoStatus]
+		if !ok {
+			return s.handleServiceError(models.ErrInvalidStatus)
+		}
+		exportReq.Statuses = append(exportReq.Statuses, exportStatus)
+	}
+
+	appointments, err := s.service.ExportAppointments(stream.Context(), exportReq)
+	if err != nil {
+		return s.handleServiceError(err)
+	}
+
+	writer := bufio.NewWriterSize(&exportStreamWriter{stream: stream}, exportChunkSize)
+	if err := records.Encode(writer, format, appointments); err != nil {
+		logrus.WithError(err).Error("Failed to stream record export")
+		return err
+	}
+	return writer.Flush()
+}
+
+// exportStreamWriter sends everything written to it as export chunks
+type exportStreamWriter struct {
+	stream pb.AppointmentService_ExportAppointmentsServer
+}
+
+func (w *exportStreamWriter) Write(p []byte) (int, error) {
+	// The caller reuses p, so the message gets its own copy
+	chunk := &pb.ExportAppointmentsChunk{Data: bytes.Clone(p)}
+	if err := w.stream.Send(chunk); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (s *AppointmentServer) ImportAppointments(stream pb.AppointmentService_ImportAppointmentsServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		if err == io.EOF {
+			return s.handleServiceError(models.ErrImportOptions)
+		}
+		return err
+	}
+	protoOpts := first.GetOptions()
+	if protoOpts == nil {
+		return s.handleServiceError(models.ErrImportOptions)
+	}
+
+	format, ok := recordFormatFromProto[protoOpts.Format]
+	if !ok {
+		return s.handleServiceError(models.ErrInvalidRecordFormat)
+	}
+	mode, ok := batchModeFromProto[protoOpts.Mode]
+	if !ok {
+		return invalidArgument("INVALID_BATCH_MODE", "invalid batch mode", "options.mode")
+	}
+
+	opts := &models.RecordImportOptions{
+		Format:     format,
+		CalendarID: protoOpts.CalendarId,
+		TimeZone:   protoOpts.TimeZone,
+		Mode:       mode,
+		DryRun:     protoOpts.DryRun,
+	}
+	logrus.WithFields(logrus.Fields{
+		"format":      opts.Format,
+		"calendar_id": opts.CalendarID,
+		"dry_run":     opts.DryRun,
+	}).Info("Importing appointment records")
+
+	var data bytes.Buffer
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if req.GetOptions() != nil {
+			return s.handleServiceError(models.ErrImportOptions)
+		}
+		if data.Len()+len(req.GetChunk()) > models.MaxImportSize {
+			return s.handleServiceError(models.ErrImportTooLarge)
+		}
+		data.Write(req.GetChunk())
+	}
+
+	report, err := s.service.ImportRecords(stream.Context(), &data, opts)
+	if err != nil {
+		return s.handleServiceError(err)
+	}
+
+	return stream.SendAndClose(s.recordReportToProto(report))
+}
+
+// handleRecordError maps the error of an imported row, naming the field that
+// could not be parsed
+func (s *AppointmentServer) handleRecordError(err error) error {
+	var recordErr *models.RecordError
+	if errors.As(err, &recordErr) {
+		return status.Error(codes.InvalidArgument, recordErr.Error())
+	}
+	return s.handleServiceError(err)
+}
+
+func (s *AppointmentServer) recordReportToProto(report *models.RecordImportReport) *pb.ImportAppointmentsReport {
+	proto := &pb.ImportAppointmentsReport{
+		Mode:      batchModeToProto[report.Mode],
+		DryRun:    report.DryRun,
+		Committed: report.Committed,
+	}
+
+	for _, result := range report.Results {
+		row := &pb.ImportedRow{
+			Line: int32(result.Line),
+		}
+		if result.ID != uuid.Nil {
+			row.AppointmentId = result.ID.String()
+		}
+
+		if result.Err != nil {
+			rowStatus := status.Convert(s.handleRecordError(result.Err))
+			row.Code = int32(rowStatus.Code())
+			row.Error = rowStatus.Message()
+			proto.Failed++
+		} else {
+			proto.Succeeded++
+		}
+
+		proto.Rows = append(proto.Rows, row)
+	}
+
+	return proto
+}
@@ -0,0 +1,77 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pasDamola/schedule-management-system/internal/requestinfo"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Metadata keys identifying the caller and the request
+const (
+	ActorHeader     = "x-actor"
+	TenantHeader    = "x-tenant-id"
+	RequestIDHeader = "x-request-id"
+)
+
+// RequestInfoUnaryInterceptor attaches the caller's actor, tenant and request ID to the
+// context so changes can be attributed in the audit log
+func RequestInfoUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := withRequestInfo(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// RequestInfoStreamInterceptor is the streaming counterpart of RequestInfoUnaryInterceptor
+func RequestInfoStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := withRequestInfo(stream.Context())
+		if err != nil {
+			return err
+		}
+		return handler(srv, &requestInfoStream{ServerStream: stream, ctx: ctx})
+	}
+}
+
+// withRequestInfo reads the actor, tenant and request ID from incoming metadata, generating a
+// request ID when the caller did not send one, and echoes the ID back as a header
+func withRequestInfo(ctx context.Context) (context.Context, error) {
+	var info requestinfo.Info
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(ActorHeader); len(values) > 0 {
+			info.Actor = values[0]
+		}
+		if values := md.Get(TenantHeader); len(values) > 0 {
+			info.Tenant = values[0]
+		}
+		if len(info.Tenant) > requestinfo.MaxTenantLength {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid tenant ID: must be at most %d characters", requestinfo.MaxTenantLength)
+		}
+		if values := md.Get(RequestIDHeader); len(values) > 0 {
+			info.RequestID = values[0]
+		}
+	}
+	if info.RequestID == "" {
+		info.RequestID = uuid.New().String()
+	}
+
+	grpc.SetHeader(ctx, metadata.Pairs(RequestIDHeader, info.RequestID))
+	return requestinfo.NewContext(ctx, info), nil
+}
+
+type requestInfoStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *requestInfoStream) Context() context.Context {
+	return s.ctx
+}
@@ -2,14 +2,13 @@ package grpc
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/google/uuid"
 	"github.com/pasDamola/schedule-management-system/internal/models"
 	"github.com/pasDamola/schedule-management-system/internal/service"
 	pb "github.com/pasDamola/schedule-management-system/pkg/pb"
 	"github.com/sirupsen/logrus"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -30,24 +29,26 @@ func (s *AppointmentServer) CreateAppointment(ctx context.Context, req *pb.Creat
 
 	// Validate request
 	if req.Title == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "title is required")
-	}
-	if req.StartTime == nil || req.EndTime == nil {
-		return nil, status.Errorf(codes.InvalidArgument, "start_time and end_time are required")
-	}
-
-	startTime := req.StartTime.AsTime()
-	endTime := req.EndTime.AsTime()
-
-	// Additional validation
-	if err := service.ValidateAppointmentTime(startTime, endTime); err != nil {
-		return nil, s.handleServiceError(err)
+		return nil, invalidArgument("INVALID_TITLE", "title is required", "title")
 	}
 
 	createReq := &models.CreateAppointmentRequest{
-		Title:     req.Title,
-		StartTime: startTime,
-		EndTime:   endTime,
+		Title:      req.Title,
+		CalendarID: req.CalendarId,
+		TimeZone:   req.TimeZone,
+		AllDay:     req.AllDay,
+		StartDate:  req.StartDate,
+		EndDate:    req.EndDate,
+	}
+
+	// Times, dates and policy are validated by the service
+	if !req.AllDay {
+		if req.StartTime == nil || req.EndTime == nil {
+			return nil, invalidArgument("INVALID_TIME", "start_time and end_time are required", "start_time", "end_time")
+		}
+
+		createReq.StartTime = req.StartTime.AsTime()
+		createReq.EndTime = req.EndTime.AsTime()
 	}
 
 	appointment, err := s.service.CreateAppointment(ctx, createReq)
@@ -61,7 +62,7 @@ func (s *AppointmentServer) CreateAppointment(ctx context.Context, req *pb.Creat
 func (s *AppointmentServer) GetAppointment(ctx context.Context, req *pb.GetAppointmentRequest) (*pb.Appointment, error) {
 	id, err := uuid.Parse(req.Id)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid appointment ID: %v", err)
+		return nil, invalidArgument("INVALID_ID", fmt.Sprintf("invalid appointment ID: %v", err), "id")
 	}
 
 	appointment, err := s.service.GetAppointment(ctx, id)
@@ -77,7 +78,7 @@ func (s *AppointmentServer) DeleteAppointment(ctx context.Context, req *pb.Delet
 
 	id, err := uuid.Parse(req.Id)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid appointment ID: %v", err)
+		return nil, invalidArgument("INVALID_ID", fmt.Sprintf("invalid appointment ID: %v", err), "id")
 	}
 
 	err = s.service.DeleteAppointment(ctx, id)
@@ -90,9 +91,10 @@ func (s *AppointmentServer) DeleteAppointment(ctx context.Context, req *pb.Delet
 
 func (s *AppointmentServer) ListAppointments(ctx context.Context, req *pb.ListAppointmentsRequest) (*pb.ListAppointmentsResponse, error) {
 	listReq := &models.ListAppointmentsRequest{
-		Page:   int(req.Page),
-		Limit:  int(req.Limit),
-		Search: req.Search,
+		Page:       int(req.Page),
+		Limit:      int(req.Limit),
+		Search:     req.Search,
+		CalendarID: req.CalendarId,
 	}
 
 	if req.StartDate != nil {
@@ -101,28 +103,25 @@ func (s *AppointmentServer) ListAppointments(ctx context.Context, req *pb.ListAp
 	if req.EndDate != nil {
 		listReq.EndDate = req.EndDate.AsTime()
 	}
+	for _, protoStatus := range req.Statuses {
+		listStatus, ok := statusFromProto[protoStatus]
+		if !ok {
+			return nil, s.handleServiceError(models.ErrInvalidStatus)
+		}
+		listReq.Statuses = append(listReq.Statuses, listStatus)
+	}
 
 	response, err := s.service.ListAppointments(ctx, listReq)
 	if err != nil {
 		return nil, s.handleServiceError(err)
 	}
 
-	protoAppointments := make([]*pb.Appointment, len(response.Appointments))
-	for i, appointment := range response.Appointments {
-		protoAppointments[i] = s.appointmentToProto(&appointment)
-	}
-
-	return &pb.ListAppointmentsResponse{
-		Appointments: protoAppointments,
-		Total:        int32(response.Total),
-		Page:         int32(response.Page),
-		Limit:        int32(response.Limit),
-	}, nil
+	return s.listResponseToProto(response), nil
 }
 
 func (s *AppointmentServer) StreamAppointments(_ *emptypb.Empty, stream pb.AppointmentService_StreamAppointmentsServer) error {
 	ctx := stream.Context()
-	eventChan := s.service.SubscribeToUpdates()
+	eventChan := s.service.SubscribeToUpdates(ctx)
 	defer s.service.UnsubscribeFromUpdates(eventChan)
 
 	logrus.Info("Client connected to appointment stream")
@@ -159,34 +158,53 @@ func (s *AppointmentServer) StreamAppointments(_ *emptypb.Empty, stream pb.Appoi
 
 // Helper methods
 func (s *AppointmentServer) appointmentToProto(appointment *models.Appointment) *pb.Appointment {
-	return &pb.Appointment{
-		Id:        appointment.ID.String(),
-		Title:     appointment.Title,
-		StartTime: timestamppb.New(appointment.StartTime),
-		EndTime:   timestamppb.New(appointment.EndTime),
-		CreatedAt: timestamppb.New(appointment.CreatedAt),
-		UpdatedAt: timestamppb.New(appointment.UpdatedAt),
+	proto := &pb.Appointment{
+		Id:         appointment.ID.String(),
+		Title:      appointment.Title,
+		StartTime:  timestamppb.New(appointment.StartTime),
+		EndTime:    timestamppb.New(appointment.EndTime),
+		CreatedAt:  timestamppb.New(appointment.CreatedAt),
+		UpdatedAt:  timestamppb.New(appointment.UpdatedAt),
+		CalendarId: appointment.CalendarID,
+		TimeZone:   appointment.TimeZone,
+		AllDay:     appointment.AllDay,
+		Status:     statusToProto[appointment.Status],
+
+		CancellationReason: appointment.CancellationReason,
+	}
+	if appointment.AllDay {
+		proto.StartDate, proto.EndDate = appointment.AllDayDates()
+	}
+	if appointment.HoldExpiresAt != nil {
+		proto.HoldExpiresAt = timestamppb.New(*appointment.HoldExpiresAt)
+	}
+	if appointment.CancelledAt != nil {
+		proto.CancelledAt = timestamppb.New(*appointment.CancelledAt)
+	}
+	if appointment.DeletedAt != nil {
+		proto.DeletedAt = timestamppb.New(*appointment.DeletedAt)
+	}
+	proto.IcalUid = appointment.ICalUID
+	proto.Owner = appointment.Owner
+	proto.CreatedBy = appointment.CreatedBy
+	return proto
+}
+
+func (s *AppointmentServer) listResponseToProto(response *models.ListAppointmentsResponse) *pb.ListAppointmentsResponse {
+	protoAppointments := make([]*pb.Appointment, len(response.Appointments))
+	for i, appointment := range response.Appointments {
+		protoAppointments[i] = s.appointmentToProto(&appointment)
+	}
+
+	return &pb.ListAppointmentsResponse{
+		Appointments: protoAppointments,
+		Total:        int32(response.Total),
+		Page:         int32(response.Page),
+		Limit:        int32(response.Limit),
 	}
 }
 
+// handleServiceError maps a service error to a gRPC status with error details
 func (s *AppointmentServer) handleServiceError(err error) error {
-	switch err {
-	case models.ErrAppointmentNotFound:
-		return status.Errorf(codes.NotFound, "appointment not found")
-	case models.ErrAppointmentConflict:
-		return status.Errorf(codes.AlreadyExists, "appointment time conflicts with existing appointment")
-	case models.ErrInvalidTitle:
-		return status.Errorf(codes.InvalidArgument, "invalid title: title cannot be empty")
-	case models.ErrInvalidTime:
-		return status.Errorf(codes.InvalidArgument, "invalid time: start time and end time are required")
-	case models.ErrInvalidTimeRange:
-		return status.Errorf(codes.InvalidArgument, "invalid time range: start time must be before end time and appointments must be 15 minutes to 8 hours long")
-	case models.ErrInvalidID:
-		return status.Errorf(codes.InvalidArgument, "invalid ID: ID cannot be empty")
-	case models.ErrPastTime:
-		return status.Errorf(codes.InvalidArgument, "invalid time: cannot schedule appointments in the past")
-	default:
-		logrus.WithError(err).Error("Unexpected service error")
-		return status.Errorf(codes.Internal, "internal server error")
-	}
+	return serviceStatus(err, requestField).Err()
 }
\ No newline at end of file
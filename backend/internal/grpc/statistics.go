@@ -0,0 +1,72 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/pasDamola/schedule-management-system/internal/models"
+	pb "github.com/pasDamola/schedule-management-system/pkg/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var statisticsGroupBy = map[pb.GetStatisticsRequest_GroupBy]models.StatisticsGroupBy{
+	pb.GetStatisticsRequest_NONE:     models.GroupByNone,
+	pb.GetStatisticsRequest_DAY:      models.GroupByDay,
+	pb.GetStatisticsRequest_WEEK:     models.GroupByWeek,
+	pb.GetStatisticsRequest_CALENDAR: models.GroupByCalendar,
+}
+
+func (s *AppointmentServer) GetStatistics(ctx context.Context, req *pb.GetStatisticsRequest) (*pb.GetStatisticsResponse, error) {
+	if req.StartDate == nil || req.EndDate == nil {
+		return nil, invalidArgument("INVALID_STATISTICS_WINDOW", "start_date and end_date are required", "start_date", "end_date")
+	}
+
+	groupBy, ok := statisticsGroupBy[req.GroupBy]
+	if !ok {
+		return nil, s.handleServiceError(models.ErrInvalidGroupBy)
+	}
+
+	statsReq := &models.StatisticsRequest{
+		StartDate: req.StartDate.AsTime(),
+		EndDate:   req.EndDate.AsTime(),
+		GroupBy:   groupBy,
+		TimeZone:  req.TimeZone,
+	}
+
+	response, err := s.service.GetStatistics(ctx, statsReq)
+	if err != nil {
+		return nil, s.handleServiceError(err)
+	}
+
+	groups := make([]*pb.AppointmentStatistics, len(response.Groups))
+	for i := range response.Groups {
+		groups[i] = statisticsToProto(&response.Groups[i])
+	}
+
+	peakHours := make([]*pb.HourCount, len(response.PeakHours))
+	for i, peak := range response.PeakHours {
+		peakHours[i] = &pb.HourCount{
+			Hour:             int32(peak.Hour),
+			AppointmentCount: int32(peak.AppointmentCount),
+		}
+	}
+
+	return &pb.GetStatisticsResponse{
+		Summary:   statisticsToProto(&response.Summary),
+		Groups:    groups,
+		PeakHours: peakHours,
+	}, nil
+}
+
+func statisticsToProto(stats *models.AppointmentStatistics) *pb.AppointmentStatistics {
+	return &pb.AppointmentStatistics{
+		Key:                    stats.Key,
+		PeriodStart:            timestamppb.New(stats.PeriodStart),
+		PeriodEnd:              timestamppb.New(stats.PeriodEnd),
+		AppointmentCount:       int32(stats.AppointmentCount),
+		BookedHours:            stats.BookedHours,
+		AvailableHours:         stats.AvailableHours,
+		Utilization:            stats.Utilization,
+		AverageDurationMinutes: stats.AverageDurationMinutes,
+		CancellationCount:      int32(stats.CancellationCount),
+	}
+}
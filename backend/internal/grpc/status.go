@@ -0,0 +1,68 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/pasDamola/schedule-management-system/internal/models"
+	pb "github.com/pasDamola/schedule-management-system/pkg/pb"
+	"github.com/sirupsen/logrus"
+)
+
+var statusFromProto = map[pb.AppointmentStatus]models.AppointmentStatus{
+	pb.AppointmentStatus_SCHEDULED: models.StatusScheduled,
+	pb.AppointmentStatus_CONFIRMED: models.StatusConfirmed,
+	pb.AppointmentStatus_CANCELLED: models.StatusCancelled,
+	pb.AppointmentStatus_COMPLETED: models.StatusCompleted,
+	pb.AppointmentStatus_NO_SHOW:   models.StatusNoShow,
+}
+
+var statusToProto = map[models.AppointmentStatus]pb.AppointmentStatus{
+	models.StatusScheduled: pb.AppointmentStatus_SCHEDULED,
+	models.StatusConfirmed: pb.AppointmentStatus_CONFIRMED,
+	models.StatusCancelled: pb.AppointmentStatus_CANCELLED,
+	models.StatusCompleted: pb.AppointmentStatus_COMPLETED,
+	models.StatusNoShow:    pb.AppointmentStatus_NO_SHOW,
+}
+
+func (s *AppointmentServer) CancelAppointment(ctx context.Context, req *pb.CancelAppointmentRequest) (*pb.Appointment, error) {
+	logrus.WithField("id", req.Id).Info("Cancelling appointment")
+
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, invalidArgument("INVALID_ID", fmt.Sprintf("invalid appointment ID: %v", err), "id")
+	}
+
+	appointment, err := s.service.CancelAppointment(ctx, id, req.Reason)
+	if err != nil {
+		return nil, s.handleServiceError(err)
+	}
+
+	return s.appointmentToProto(appointment), nil
+}
+
+func (s *AppointmentServer) UpdateAppointmentStatus(ctx context.Context, req *pb.UpdateAppointmentStatusRequest) (*pb.Appointment, error) {
+	logrus.WithFields(logrus.Fields{"id": req.Id, "status": req.Status}).Info("Updating appointment status")
+
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, invalidArgument("INVALID_ID", fmt.Sprintf("invalid appointment ID: %v", err), "id")
+	}
+
+	newStatus, ok := statusFromProto[req.Status]
+	if !ok {
+		return nil, s.handleServiceError(models.ErrInvalidStatus)
+	}
+
+	appointment, err := s.service.UpdateAppointmentStatus(ctx, &models.UpdateStatusRequest{
+		ID:     id,
+		Status: newStatus,
+		Reason: req.Reason,
+	})
+	if err != nil {
+		return nil, s.handleServiceError(err)
+	}
+
+	return s.appointmentToProto(appointment), nil
+}
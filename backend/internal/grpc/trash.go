@@ -0,0 +1,55 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/pasDamola/schedule-management-system/internal/models"
+	pb "github.com/pasDamola/schedule-management-system/pkg/pb"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *AppointmentServer) RestoreAppointment(ctx context.Context, req *pb.RestoreAppointmentRequest) (*pb.Appointment, error) {
+	logrus.WithField("id", req.Id).Info("Restoring appointment")
+
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, invalidArgument("INVALID_ID", fmt.Sprintf("invalid appointment ID: %v", err), "id")
+	}
+
+	appointment, err := s.service.RestoreAppointment(ctx, id)
+	if err != nil {
+		return nil, s.handleServiceError(err)
+	}
+
+	return s.appointmentToProto(appointment), nil
+}
+
+func (s *AppointmentServer) ListDeletedAppointments(ctx context.Context, req *pb.ListDeletedAppointmentsRequest) (*pb.ListAppointmentsResponse, error) {
+	response, err := s.service.ListDeletedAppointments(ctx, &models.ListAppointmentsRequest{
+		Page:  int(req.Page),
+		Limit: int(req.Limit),
+	})
+	if err != nil {
+		return nil, s.handleServiceError(err)
+	}
+
+	return s.listResponseToProto(response), nil
+}
+
+func (s *AppointmentServer) PurgeAppointment(ctx context.Context, req *pb.PurgeAppointmentRequest) (*emptypb.Empty, error) {
+	logrus.WithField("id", req.Id).Info("Purging appointment")
+
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, invalidArgument("INVALID_ID", fmt.Sprintf("invalid appointment ID: %v", err), "id")
+	}
+
+	if err := s.service.PurgeAppointment(ctx, id); err != nil {
+		return nil, s.handleServiceError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,140 @@
+// Package health reports whether the server can take traffic, over the standard
+// grpc.health.v1 service and as HTTP liveness and readiness probes.
+package health
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	pb "github.com/pasDamola/schedule-management-system/pkg/pb"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// ServiceName is the health service name of the AppointmentService
+var ServiceName = pb.AppointmentService_ServiceDesc.ServiceName
+
+// Pinger is what the checker needs from the database
+type Pinger interface {
+	PingContext(ctx context.Context) error
+}
+
+// services are reported individually; "" stands for the server as a whole
+var services = []string{"", ServiceName}
+
+// Checker pings the database periodically and publishes the result through a
+// grpc.health.v1 server: every service is SERVING while the database answers
+// and NOT_SERVING otherwise, and for good once Shutdown is called.
+type Checker struct {
+	db           Pinger
+	interval     time.Duration
+	timeout      time.Duration
+	server       *health.Server
+	shuttingDown atomic.Bool
+}
+
+func NewChecker(db Pinger, interval, timeout time.Duration) *Checker {
+	server := health.NewServer()
+	for _, service := range services {
+		server.SetServingStatus(service, healthpb.HealthCheckResponse_NOT_SERVING)
+	}
+
+	return &Checker{
+		db:       db,
+		interval: interval,
+		timeout:  timeout,
+		server:   server,
+	}
+}
+
+// Server returns the grpc.health.v1 implementation to register on the gRPC server
+func (c *Checker) Server() *health.Server {
+	return c.server
+}
+
+// Run checks the database every interval until ctx is done
+func (c *Checker) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	c.check(ctx)
+	for {
+		select {
+		case <-ticker.C:
+			c.check(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (c *Checker) check(ctx context.Context) {
+	if c.shuttingDown.Load() {
+		return
+	}
+
+	pingCtx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	status := healthpb.HealthCheckResponse_SERVING
+	if err := c.db.PingContext(pingCtx); err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+		logrus.WithError(err).Warn("Database health check failed")
+		status = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+
+	if c.status(ServiceName) != status {
+		logrus.WithField("status", status.String()).Info("Health status changed")
+	}
+	for _, service := range services {
+		c.server.SetServingStatus(service, status)
+	}
+}
+
+// Shutdown marks every service NOT_SERVING for good, so load balancers stop
+// sending traffic while in-flight calls drain
+func (c *Checker) Shutdown() {
+	c.shuttingDown.Store(true)
+	c.server.Shutdown()
+}
+
+func (c *Checker) status(service string) healthpb.HealthCheckResponse_ServingStatus {
+	response, err := c.server.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+	if err != nil {
+		return healthpb.HealthCheckResponse_SERVICE_UNKNOWN
+	}
+	return response.Status
+}
+
+// Liveness answers 200 until the server starts shutting down. It does not depend
+// on the database, so an outage does not get the process restarted.
+func (c *Checker) Liveness() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		status := healthpb.HealthCheckResponse_SERVING
+		if c.shuttingDown.Load() {
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+		writeProbe(w, status)
+	})
+}
+
+// Readiness answers 200 while the gRPC health status of the server is SERVING
+func (c *Checker) Readiness() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeProbe(w, c.status(""))
+	})
+}
+
+func writeProbe(w http.ResponseWriter, status healthpb.HealthCheckResponse_ServingStatus) {
+	w.Header().Set("Cache-Control", "no-store")
+	if status != healthpb.HealthCheckResponse_SERVING {
+		http.Error(w, status.String(), http.StatusServiceUnavailable)
+		return
+	}
+	w.Write([]byte(status.String() + "\n"))
+}
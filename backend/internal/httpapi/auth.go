@@ -0,0 +1,93 @@
+package httpapi
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/pasDamola/schedule-management-system/internal/auth"
+	"github.com/pasDamola/schedule-management-system/internal/models"
+	"github.com/pasDamola/schedule-management-system/internal/requestinfo"
+	"github.com/pasDamola/schedule-management-system/internal/service"
+	"github.com/sirupsen/logrus"
+)
+
+// tenantHeader names the tenant of unauthenticated requests, like the gRPC
+// server's x-tenant-id metadata
+const tenantHeader = "X-Tenant-Id"
+
+// apiKeyHeader carries an API key, like the gRPC server's x-api-key metadata
+const apiKeyHeader = "X-Api-Key"
+
+// readMethods are the HTTP methods an appointments:read key may use; the rest,
+// such as CalDAV's PUT and DELETE, need appointments:write
+var readMethods = map[string]bool{
+	http.MethodGet:     true,
+	http.MethodHead:    true,
+	http.MethodOptions: true,
+	"PROPFIND":         true,
+	"REPORT":           true,
+}
+
+// requireAuth applies the same bearer token and API key checks as the gRPC
+// server to next. With a nil verifier bearer tokens are not required; requests
+// without an API key then take their tenant from the X-Tenant-Id header.
+func requireAuth(verifier *auth.Verifier, apiKeys service.AppointmentService, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var principal *auth.Principal
+		if key := r.Header.Get(apiKeyHeader); key != "" {
+			var err error
+			principal, err = apiKeys.AuthenticateAPIKey(r.Context(), key)
+			if err != nil {
+				if err == models.ErrInvalidAPIKey {
+					unauthorized(w, err)
+					return
+				}
+				logrus.WithError(err).Error("Failed to verify API key")
+				http.Error(w, "unable to verify API key", http.StatusServiceUnavailable)
+				return
+			}
+		} else if verifier == nil {
+			tenant := r.Header.Get(tenantHeader)
+			if len(tenant) > requestinfo.MaxTenantLength {
+				http.Error(w, "invalid tenant ID", http.StatusBadRequest)
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(requestinfo.WithTenant(r.Context(), tenant)))
+			return
+		} else {
+			token, err := auth.BearerToken(r.Header.Get("Authorization"))
+			if err != nil {
+				unauthorized(w, err)
+				return
+			}
+
+			principal, err = verifier.Verify(r.Context(), token)
+			if err != nil {
+				if errors.Is(err, auth.ErrInvalidToken) {
+					unauthorized(w, auth.ErrInvalidToken)
+					return
+				}
+				logrus.WithError(err).Error("Failed to verify bearer token")
+				http.Error(w, "unable to verify bearer token", http.StatusServiceUnavailable)
+				return
+			}
+		}
+
+		scope := models.ScopeAppointmentsWrite
+		if readMethods[r.Method] {
+			scope = models.ScopeAppointmentsRead
+		}
+		if !principal.HasScope(scope) {
+			http.Error(w, "missing scope "+scope, http.StatusForbidden)
+			return
+		}
+
+		ctx := requestinfo.NewContext(r.Context(), requestinfo.Info{Actor: principal.Subject, Tenant: principal.Tenant})
+		next.ServeHTTP(w, r.WithContext(auth.NewContext(ctx, principal)))
+	})
+}
+
+func unauthorized(w http.ResponseWriter, err error) {
+	w.Header().Set("WWW-Authenticate", `Bearer realm="schedule"`)
+	http.Error(w, err.Error(), http.StatusUnauthorized)
+}
@@ -0,0 +1,283 @@
+package httpapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/emersion/go-ical"
+	"github.com/emersion/go-webdav"
+	"github.com/emersion/go-webdav/caldav"
+	"github.com/pasDamola/schedule-management-system/internal/icalendar"
+	"github.com/pasDamola/schedule-management-system/internal/models"
+	"github.com/pasDamola/schedule-management-system/internal/service"
+	"github.com/sirupsen/logrus"
+)
+
+// CalDAV layout: a single principal whose home set holds one collection per
+// calendar ID, with one resource per appointment named after its event UID
+const (
+	caldavPrefix        = "/caldav"
+	caldavPrincipalPath = caldavPrefix + "/user/"
+	caldavHomeSetPath   = caldavPrincipalPath + "calendars/"
+
+	// caldavMaxResourceSize limits a single uploaded event
+	caldavMaxResourceSize = 1 << 20
+)
+
+var (
+	errNotCalendarPath = errors.New("not a calendar path")
+	errNotObjectPath   = errors.New("not a calendar object path")
+	errUIDMismatch     = errors.New("resource name must be the event UID followed by .ics")
+	errPrecondition    = errors.New("resource was modified or already exists")
+)
+
+// caldavBackend exposes appointments through the CalDAV subset implemented by
+// go-webdav: PROPFIND, REPORT calendar-query and calendar-multiget, and GET, PUT
+// and DELETE of .ics resources. Writes go through AppointmentService so they are
+// validated, conflict checked, audited and streamed like any other change.
+type caldavBackend struct {
+	service service.AppointmentService
+}
+
+func newCalDAVHandler(service service.AppointmentService) http.Handler {
+	return &caldav.Handler{
+		Backend: &caldavBackend{service: service},
+		Prefix:  caldavPrefix,
+	}
+}
+
+func (b *caldavBackend) CurrentUserPrincipal(ctx context.Context) (string, error) {
+	return caldavPrincipalPath, nil
+}
+
+func (b *caldavBackend) CalendarHomeSetPath(ctx context.Context) (string, error) {
+	return caldavHomeSetPath, nil
+}
+
+// CreateCalendar accepts any valid calendar ID; calendars exist implicitly
+func (b *caldavBackend) CreateCalendar(ctx context.Context, calendar *caldav.Calendar) error {
+	calendarID, err := parseCalendarPath(calendar.Path)
+	if err != nil {
+		return webdav.NewHTTPError(http.StatusNotFound, err)
+	}
+	if len(calendarID) > 100 {
+		return caldavError(models.ErrInvalidCalendarID)
+	}
+	return nil
+}
+
+func (b *caldavBackend) ListCalendars(ctx context.Context) ([]caldav.Calendar, error) {
+	calendarIDs, err := b.service.ListCalendars(ctx)
+	if err != nil {
+		return nil, caldavError(err)
+	}
+
+	calendars := make([]caldav.Calendar, 0, len(calendarIDs))
+	for _, calendarID := range calendarIDs {
+		calendars = append(calendars, *newCalDAVCalendar(calendarID))
+	}
+	return calendars, nil
+}
+
+func (b *caldavBackend) GetCalendar(ctx context.Context, calendarPath string) (*caldav.Calendar, error) {
+	calendarID, err := parseCalendarPath(calendarPath)
+	if err != nil {
+		return nil, webdav.NewHTTPError(http.StatusNotFound, err)
+	}
+	return newCalDAVCalendar(calendarID), nil
+}
+
+func (b *caldavBackend) GetCalendarObject(ctx context.Context, objectPath string, req *caldav.CalendarCompRequest) (*caldav.CalendarObject, error) {
+	calendarID, uid, err := parseObjectPath(objectPath)
+	if err != nil {
+		return nil, webdav.NewHTTPError(http.StatusNotFound, err)
+	}
+
+	appointment, err := b.service.GetAppointmentByICalUID(ctx, calendarID, uid)
+	if err != nil {
+		return nil, caldavError(err)
+	}
+
+	return newCalDAVObject(appointment), nil
+}
+
+// ListCalendarObjects publishes the whole calendar, however large it has grown
+func (b *caldavBackend) ListCalendarObjects(ctx context.Context, calendarPath string, req *caldav.CalendarCompRequest) ([]caldav.CalendarObject, error) {
+	calendarID, err := parseCalendarPath(calendarPath)
+	if err != nil {
+		return nil, webdav.NewHTTPError(http.StatusNotFound, err)
+	}
+
+	appointments, err := b.service.ListCalendarAppointments(ctx, calendarID, time.Time{})
+	if err != nil {
+		return nil, caldavError(err)
+	}
+
+	objects := make([]caldav.CalendarObject, 0, len(appointments))
+	for i := range appointments {
+		objects = append(objects, *newCalDAVObject(&appointments[i]))
+	}
+	return objects, nil
+}
+
+// QueryCalendarObjects applies calendar-query filters, such as time ranges, to
+// the whole calendar in memory
+func (b *caldavBackend) QueryCalendarObjects(ctx context.Context, calendarPath string, query *caldav.CalendarQuery) ([]caldav.CalendarObject, error) {
+	objects, err := b.ListCalendarObjects(ctx, calendarPath, &query.CompRequest)
+	if err != nil {
+		return nil, err
+	}
+	return caldav.Filter(query, objects)
+}
+
+// PutCalendarObject creates the appointment for a new resource or replaces the
+// one already published under the same UID
+func (b *caldavBackend) PutCalendarObject(ctx context.Context, objectPath string, cal *ical.Calendar, opts *caldav.PutCalendarObjectOptions) (*caldav.CalendarObject, error) {
+	calendarID, uid, err := parseObjectPath(objectPath)
+	if err != nil {
+		return nil, webdav.NewHTTPError(http.StatusForbidden, err)
+	}
+
+	_, calUID, err := caldav.ValidateCalendarObject(cal)
+	if err != nil {
+		return nil, webdav.NewHTTPError(http.StatusBadRequest, err)
+	}
+	if calUID != uid {
+		return nil, webdav.NewHTTPError(http.StatusBadRequest, errUIDMismatch)
+	}
+
+	existing, err := b.service.GetAppointmentByICalUID(ctx, calendarID, uid)
+	if err != nil && err != models.ErrAppointmentNotFound {
+		return nil, caldavError(err)
+	}
+	if err := checkPreconditions(existing, opts); err != nil {
+		return nil, err
+	}
+
+	events := icalendar.DecodeCalendar(cal, models.DefaultTimeZone)
+	if len(events) != 1 {
+		return nil, caldavError(models.ErrUnsupportedICal)
+	}
+	if events[0].Err != nil {
+		return nil, caldavError(events[0].Err)
+	}
+	req := events[0].Request
+	req.CalendarID = calendarID
+
+	var appointment *models.Appointment
+	if existing != nil {
+		appointment, err = b.service.ReplaceAppointment(ctx, existing.ID, req)
+	} else {
+		appointment, err = b.service.CreateAppointment(ctx, req)
+	}
+	if err != nil {
+		return nil, caldavError(err)
+	}
+
+	return newCalDAVObject(appointment), nil
+}
+
+func (b *caldavBackend) DeleteCalendarObject(ctx context.Context, objectPath string) error {
+	calendarID, uid, err := parseObjectPath(objectPath)
+	if err != nil {
+		return webdav.NewHTTPError(http.StatusNotFound, err)
+	}
+
+	appointment, err := b.service.GetAppointmentByICalUID(ctx, calendarID, uid)
+	if err != nil {
+		return caldavError(err)
+	}
+
+	if err := b.service.DeleteAppointment(ctx, appointment.ID); err != nil {
+		return caldavError(err)
+	}
+	return nil
+}
+
+// checkPreconditions applies If-None-Match and If-Match to a PUT
+func checkPreconditions(existing *models.Appointment, opts *caldav.PutCalendarObjectOptions) error {
+	if opts.IfNoneMatch.IsSet() && existing != nil {
+		if opts.IfNoneMatch.IsWildcard() {
+			return webdav.NewHTTPError(http.StatusPreconditionFailed, errPrecondition)
+		}
+		if etag, err := opts.IfNoneMatch.ETag(); err == nil && etag == caldavETag(existing) {
+			return webdav.NewHTTPError(http.StatusPreconditionFailed, errPrecondition)
+		}
+	}
+
+	if opts.IfMatch.IsSet() {
+		if existing == nil {
+			return webdav.NewHTTPError(http.StatusPreconditionFailed, errPrecondition)
+		}
+		if !opts.IfMatch.IsWildcard() {
+			if etag, err := opts.IfMatch.ETag(); err != nil || etag != caldavETag(existing) {
+				return webdav.NewHTTPError(http.StatusPreconditionFailed, errPrecondition)
+			}
+		}
+	}
+
+	return nil
+}
+
+func newCalDAVCalendar(calendarID string) *caldav.Calendar {
+	return &caldav.Calendar{
+		Path:                  caldavHomeSetPath + calendarID + "/",
+		Name:                  calendarID,
+		MaxResourceSize:       caldavMaxResourceSize,
+		SupportedComponentSet: []string{ical.CompEvent},
+	}
+}
+
+func newCalDAVObject(appointment *models.Appointment) *caldav.CalendarObject {
+	return &caldav.CalendarObject{
+		Path:    caldavHomeSetPath + appointment.CalendarID + "/" + icalendar.EventUID(appointment) + ".ics",
+		ModTime: appointment.UpdatedAt,
+		ETag:    caldavETag(appointment),
+		Data:    icalendar.NewObject(appointment),
+	}
+}
+
+// caldavETag changes whenever the appointment is modified
+func caldavETag(appointment *models.Appointment) string {
+	return strconv.FormatInt(appointment.UpdatedAt.UnixNano(), 36)
+}
+
+// parseCalendarPath extracts the calendar ID from /caldav/user/calendars/{id}/
+func parseCalendarPath(calendarPath string) (string, error) {
+	rest, ok := strings.CutPrefix(path.Clean(calendarPath), path.Clean(caldavHomeSetPath)+"/")
+	if !ok || rest == "" || strings.Contains(rest, "/") {
+		return "", errNotCalendarPath
+	}
+	return rest, nil
+}
+
+// parseObjectPath extracts the calendar ID and event UID from
+// /caldav/user/calendars/{id}/{uid}.ics
+func parseObjectPath(objectPath string) (string, string, error) {
+	dir, file := path.Split(path.Clean(objectPath))
+	calendarID, err := parseCalendarPath(dir)
+	if err != nil {
+		return "", "", errNotObjectPath
+	}
+	uid, ok := strings.CutSuffix(file, ".ics")
+	if !ok || uid == "" {
+		return "", "", errNotObjectPath
+	}
+	return calendarID, uid, nil
+}
+
+// caldavError maps a service error to the HTTP error go-webdav writes back,
+// hiding the details of unexpected errors
+func caldavError(err error) error {
+	code := httpStatus(err)
+	if code == http.StatusInternalServerError {
+		logrus.WithError(err).Error("Unexpected CalDAV error")
+		return webdav.NewHTTPError(code, errors.New("internal server error"))
+	}
+	return webdav.NewHTTPError(code, err)
+}
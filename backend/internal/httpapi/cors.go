@@ -0,0 +1,20 @@
+package httpapi
+
+import (
+	"net/http"
+
+	connectcors "connectrpc.com/cors"
+	"github.com/rs/cors"
+)
+
+// WithCORS lets browsers on allowedOrigins call next, including the Connect and
+// gRPC-Web protocols and the request ID, actor and trace context headers
+func WithCORS(next http.Handler, allowedOrigins []string) http.Handler {
+	return cors.New(cors.Options{
+		AllowedOrigins: allowedOrigins,
+		AllowedMethods: connectcors.AllowedMethods(),
+		AllowedHeaders: append(connectcors.AllowedHeaders(), "Authorization", "X-Api-Key", "X-Actor", "X-Tenant-Id", "X-Request-Id", "Traceparent", "Tracestate", "Baggage"),
+		ExposedHeaders: append(connectcors.ExposedHeaders(), "X-Request-Id", "ETag"),
+		MaxAge:         7200,
+	}).Handler(next)
+}
@@ -0,0 +1,58 @@
+package httpapi
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pasDamola/schedule-management-system/internal/icalendar"
+	"github.com/pasDamola/schedule-management-system/internal/models"
+)
+
+// exportICS serves GET /export.ics with the same filters as ListAppointments:
+// calendar_id, search, start_date and end_date (RFC 3339) and repeated status
+func (s *Server) exportICS(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	req := &models.ListAppointmentsRequest{
+		Search:     query.Get("search"),
+		CalendarID: query.Get("calendar_id"),
+	}
+
+	var err error
+	if req.StartDate, err = parseTimeParam(query.Get("start_date")); err != nil {
+		http.Error(w, fmt.Sprintf("invalid start_date: %v", err), http.StatusBadRequest)
+		return
+	}
+	if req.EndDate, err = parseTimeParam(query.Get("end_date")); err != nil {
+		http.Error(w, fmt.Sprintf("invalid end_date: %v", err), http.StatusBadRequest)
+		return
+	}
+	for _, status := range query["status"] {
+		req.Statuses = append(req.Statuses, models.AppointmentStatus(status))
+	}
+
+	appointments, err := s.service.ExportAppointments(r.Context(), req)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := icalendar.Encode(&buf, req.CalendarID, appointments); err != nil {
+		writeServiceError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", icalendar.ContentType)
+	w.Header().Set("Content-Disposition", `attachment; filename="appointments.ics"`)
+	w.Write(buf.Bytes())
+}
+
+// parseTimeParam parses an optional RFC 3339 query parameter
+func parseTimeParam(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
@@ -0,0 +1,64 @@
+package httpapi
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+
+	"github.com/pasDamola/schedule-management-system/internal/icalendar"
+	"github.com/pasDamola/schedule-management-system/internal/requestinfo"
+)
+
+// feed serves GET /feeds/{token}.ics, answering 304 Not Modified when the
+// client's If-None-Match still matches the feed's ETag
+func (s *Server) feed(w http.ResponseWriter, r *http.Request) {
+	token, ok := strings.CutSuffix(r.PathValue("file"), ".ics")
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	feed, err := s.service.GetFeed(r.Context(), token)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+
+	w.Header().Set("ETag", feed.ETag)
+	w.Header().Set("Cache-Control", "private, no-cache")
+	if !feed.LastModified.IsZero() {
+		w.Header().Set("Last-Modified", feed.LastModified.UTC().Format(http.TimeFormat))
+	}
+
+	if etagMatches(r.Header.Get("If-None-Match"), feed.ETag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	ctx := requestinfo.WithTenant(r.Context(), feed.TenantID)
+	appointments, err := s.service.ListCalendarAppointments(ctx, feed.CalendarID, feed.Since)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := icalendar.Encode(&buf, feed.CalendarID, appointments); err != nil {
+		writeServiceError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", icalendar.ContentType)
+	w.Write(buf.Bytes())
+}
+
+// etagMatches reports whether an If-None-Match header lists etag, or is "*"
+func etagMatches(header, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
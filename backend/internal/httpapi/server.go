@@ -0,0 +1,79 @@
+// Package httpapi serves the plain HTTP endpoints that sit next to the gRPC API
+package httpapi
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/pasDamola/schedule-management-system/internal/auth"
+	"github.com/pasDamola/schedule-management-system/internal/models"
+	"github.com/pasDamola/schedule-management-system/internal/service"
+	"github.com/sirupsen/logrus"
+)
+
+type Server struct {
+	service service.AppointmentService
+	mux     *http.ServeMux
+}
+
+// NewServer returns the HTTP routes of service. When verifier is set, the export
+// and CalDAV routes require a bearer token or API key, and API keys are checked
+// whenever they are sent; feeds are protected by their own tokens in the URL.
+func NewServer(service service.AppointmentService, verifier *auth.Verifier) *Server {
+	s := &Server{
+		service: service,
+		mux:     http.NewServeMux(),
+	}
+
+	s.mux.Handle("GET /export.ics", requireAuth(verifier, service, http.HandlerFunc(s.exportICS)))
+	s.mux.HandleFunc("GET /feeds/{file}", s.feed)
+
+	caldavHandler := requireAuth(verifier, service, newCalDAVHandler(service))
+	s.mux.Handle(caldavPrefix+"/", caldavHandler)
+	s.mux.Handle("/.well-known/caldav", caldavHandler)
+
+	return s
+}
+
+// Handle mounts another handler, such as the Connect API, next to the built-in routes
+func (s *Server) Handle(pattern string, handler http.Handler) {
+	s.mux.Handle(pattern, handler)
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// writeServiceError writes a service error as a plain text response
+func writeServiceError(w http.ResponseWriter, err error) {
+	code := httpStatus(err)
+	if code == http.StatusInternalServerError {
+		logrus.WithError(err).Error("Unexpected service error")
+		http.Error(w, "internal server error", code)
+		return
+	}
+	http.Error(w, err.Error(), code)
+}
+
+// httpStatus maps a service error to an HTTP status code
+func httpStatus(err error) int {
+	switch {
+	case errors.Is(err, models.ErrAppointmentNotFound), errors.Is(err, models.ErrFeedNotFound),
+		errors.Is(err, models.ErrAPIKeyNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, models.ErrAppointmentConflict):
+		return http.StatusConflict
+	case errors.Is(err, models.ErrPermissionDenied):
+		return http.StatusForbidden
+	case errors.Is(err, models.ErrInvalidTitle), errors.Is(err, models.ErrInvalidTime),
+		errors.Is(err, models.ErrInvalidTimeRange), errors.Is(err, models.ErrInvalidID),
+		errors.Is(err, models.ErrPastTime), errors.Is(err, models.ErrInvalidCalendarID),
+		errors.Is(err, models.ErrInvalidTimeZone), errors.Is(err, models.ErrInvalidDate),
+		errors.Is(err, models.ErrInvalidStatus), errors.Is(err, models.ErrExportTooLarge),
+		errors.Is(err, models.ErrInvalidICS), errors.Is(err, models.ErrInvalidICalUID),
+		errors.Is(err, models.ErrUnsupportedICal):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
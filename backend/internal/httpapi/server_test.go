@@ -0,0 +1,34 @@
+package httpapi
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/pasDamola/schedule-management-system/internal/models"
+)
+
+func TestHTTPStatus(t *testing.T) {
+	tests := []struct {
+		err  error
+		want int
+	}{
+		{models.ErrAppointmentNotFound, http.StatusNotFound},
+		{models.ErrFeedNotFound, http.StatusNotFound},
+		{&models.ConflictError{}, http.StatusConflict},
+		{models.ErrPermissionDenied, http.StatusForbidden},
+		{models.ErrUnsupportedICal, http.StatusBadRequest},
+		{fmt.Errorf("decode: %w", models.ErrInvalidICS), http.StatusBadRequest},
+		{models.ErrPastTime, http.StatusBadRequest},
+		{errors.New("connection reset"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.err.Error(), func(t *testing.T) {
+			if got := httpStatus(tt.err); got != tt.want {
+				t.Errorf("httpStatus() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
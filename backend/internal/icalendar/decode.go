@@ -0,0 +1,147 @@
+package icalendar
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/emersion/go-ical"
+	"github.com/pasDamola/schedule-management-system/internal/models"
+)
+
+// DecodedEvent is a VEVENT mapped to a create request. Err is set, with a models
+// error, when the event cannot be imported; Request then holds what could be read.
+type DecodedEvent struct {
+	UID     string
+	Request *models.CreateAppointmentRequest
+	Err     error
+}
+
+// Decode reads the VEVENTs of every VCALENDAR in r. Floating times and all-day
+// events are placed in timeZone. Only a malformed file is returned as an error.
+func Decode(r io.Reader, timeZone string) ([]DecodedEvent, error) {
+	if _, err := models.LoadTimeZone(timeZone); err != nil {
+		return nil, err
+	}
+
+	var events []DecodedEvent
+	dec := ical.NewDecoder(r)
+	for {
+		cal, err := dec.Decode()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode iCalendar data: %v", err)
+		}
+
+		events = append(events, DecodeCalendar(cal, timeZone)...)
+	}
+
+	return events, nil
+}
+
+// DecodeCalendar maps the VEVENTs of a single, already parsed VCALENDAR.
+// timeZone must be a valid zone name.
+func DecodeCalendar(cal *ical.Calendar, timeZone string) []DecodedEvent {
+	loc, err := models.LoadTimeZone(timeZone)
+	if err != nil {
+		loc = time.UTC
+	}
+
+	var events []DecodedEvent
+	for _, event := range cal.Events() {
+		events = append(events, decodeEvent(event, timeZone, loc))
+	}
+	return events
+}
+
+func decodeEvent(event ical.Event, timeZone string, defaultLoc *time.Location) DecodedEvent {
+	uid, _ := event.Props.Text(ical.PropUID)
+	title, _ := event.Props.Text(ical.PropSummary)
+	decoded := DecodedEvent{
+		UID: uid,
+		Request: &models.CreateAppointmentRequest{
+			Title:    strings.TrimSpace(title),
+			TimeZone: timeZone,
+			ICalUID:  uid,
+		},
+	}
+
+	if uid == "" {
+		decoded.Err = models.ErrInvalidICS
+		return decoded
+	}
+
+	// Recurrences and overrides have no equivalent here
+	for _, name := range []string{ical.PropRecurrenceRule, ical.PropRecurrenceDates, ical.PropRecurrenceID} {
+		if event.Props.Get(name) != nil {
+			decoded.Err = models.ErrUnsupportedICal
+			return decoded
+		}
+	}
+	if status, _ := event.Status(); status == ical.EventCancelled {
+		decoded.Err = models.ErrUnsupportedICal
+		return decoded
+	}
+
+	dtstart := event.Props.Get(ical.PropDateTimeStart)
+	if dtstart == nil {
+		decoded.Err = models.ErrInvalidICS
+		return decoded
+	}
+
+	if dtstart.ValueType() == ical.ValueDate || len(dtstart.Value) == len("20060102") {
+		decoded.Err = decodeAllDay(event, decoded.Request, defaultLoc)
+		return decoded
+	}
+
+	loc := defaultLoc
+	if tzid := dtstart.Params.Get(ical.PropTimezoneID); tzid != "" {
+		tzLoc, err := models.LoadTimeZone(tzid)
+		if err != nil {
+			decoded.Err = err
+			return decoded
+		}
+		loc = tzLoc
+		decoded.Request.TimeZone = tzid
+	}
+
+	start, err := event.DateTimeStart(loc)
+	if err != nil {
+		decoded.Err = models.ErrInvalidICS
+		return decoded
+	}
+	end, err := event.DateTimeEnd(loc)
+	if err != nil {
+		decoded.Err = models.ErrInvalidICS
+		return decoded
+	}
+
+	decoded.Request.StartTime = start
+	decoded.Request.EndTime = end
+	return decoded
+}
+
+// decodeAllDay fills in the inclusive dates of a date-only event, whose DTEND is exclusive
+func decodeAllDay(event ical.Event, req *models.CreateAppointmentRequest, loc *time.Location) error {
+	start, err := event.DateTimeStart(loc)
+	if err != nil {
+		return models.ErrInvalidICS
+	}
+	end, err := event.DateTimeEnd(loc)
+	if err != nil {
+		return models.ErrInvalidICS
+	}
+
+	lastDay := end.AddDate(0, 0, -1)
+	if lastDay.Before(start) {
+		lastDay = start
+	}
+
+	req.AllDay = true
+	req.StartDate = start.Format(models.DateLayout)
+	req.EndDate = lastDay.Format(models.DateLayout)
+	return nil
+}
@@ -0,0 +1,176 @@
+package icalendar
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pasDamola/schedule-management-system/internal/models"
+)
+
+// calendarWith wraps VEVENT bodies in a VCALENDAR, joining lines with CRLF
+func calendarWith(events ...string) string {
+	lines := []string{"BEGIN:VCALENDAR", "VERSION:2.0", "PRODID:-//test//EN"}
+	for _, event := range events {
+		lines = append(lines, "BEGIN:VEVENT")
+		lines = append(lines, strings.Split(event, "\n")...)
+		lines = append(lines, "END:VEVENT")
+	}
+	lines = append(lines, "END:VCALENDAR")
+	return strings.Join(lines, "\r\n") + "\r\n"
+}
+
+func TestDecode(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+
+	const stamp = "UID:event@example.com\nDTSTAMP:20250101T000000Z\nSUMMARY: Review \n"
+	tests := []struct {
+		name      string
+		event     string
+		wantErr   error
+		wantZone  string
+		wantStart time.Time
+		wantEnd   time.Time
+		wantDates [2]string
+	}{
+		{
+			name:      "UTC times",
+			event:     stamp + "DTSTART:20250303T090000Z\nDTEND:20250303T100000Z",
+			wantZone:  "Europe/Berlin",
+			wantStart: time.Date(2025, 3, 3, 9, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2025, 3, 3, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "times in a named zone",
+			event:     stamp + "DTSTART;TZID=America/New_York:20250303T090000\nDTEND;TZID=America/New_York:20250303T100000",
+			wantZone:  "America/New_York",
+			wantStart: time.Date(2025, 3, 3, 9, 0, 0, 0, newYork),
+			wantEnd:   time.Date(2025, 3, 3, 10, 0, 0, 0, newYork),
+		},
+		{
+			name:      "floating times",
+			event:     stamp + "DTSTART:20250303T090000\nDTEND:20250303T100000",
+			wantZone:  "Europe/Berlin",
+			wantStart: time.Date(2025, 3, 3, 9, 0, 0, 0, berlin),
+			wantEnd:   time.Date(2025, 3, 3, 10, 0, 0, 0, berlin),
+		},
+		{
+			name:      "one all-day date",
+			event:     stamp + "DTSTART;VALUE=DATE:20250303\nDTEND;VALUE=DATE:20250304",
+			wantZone:  "Europe/Berlin",
+			wantDates: [2]string{"2025-03-03", "2025-03-03"},
+		},
+		{
+			name:      "several all-day dates",
+			event:     stamp + "DTSTART;VALUE=DATE:20250303\nDTEND;VALUE=DATE:20250306",
+			wantZone:  "Europe/Berlin",
+			wantDates: [2]string{"2025-03-03", "2025-03-05"},
+		},
+		{
+			name:    "no UID",
+			event:   "DTSTAMP:20250101T000000Z\nDTSTART:20250303T090000Z\nDTEND:20250303T100000Z",
+			wantErr: models.ErrInvalidICS,
+		},
+		{
+			name:    "no start",
+			event:   stamp + "DTEND:20250303T100000Z",
+			wantErr: models.ErrInvalidICS,
+		},
+		{
+			name:    "unknown zone",
+			event:   stamp + "DTSTART;TZID=Mars/Olympus:20250303T090000\nDTEND;TZID=Mars/Olympus:20250303T100000",
+			wantErr: models.ErrInvalidTimeZone,
+		},
+		{
+			name:    "recurring",
+			event:   stamp + "DTSTART:20250303T090000Z\nDTEND:20250303T100000Z\nRRULE:FREQ=WEEKLY",
+			wantErr: models.ErrUnsupportedICal,
+		},
+		{
+			name:    "override",
+			event:   stamp + "DTSTART:20250303T090000Z\nDTEND:20250303T100000Z\nRECURRENCE-ID:20250303T090000Z",
+			wantErr: models.ErrUnsupportedICal,
+		},
+		{
+			name:    "cancelled",
+			event:   stamp + "DTSTART:20250303T090000Z\nDTEND:20250303T100000Z\nSTATUS:CANCELLED",
+			wantErr: models.ErrUnsupportedICal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events, err := Decode(strings.NewReader(calendarWith(tt.event)), "Europe/Berlin")
+			if err != nil {
+				t.Fatalf("Decode() error = %v", err)
+			}
+			if len(events) != 1 {
+				t.Fatalf("got %d events, want 1", len(events))
+			}
+
+			event := events[0]
+			if !errors.Is(event.Err, tt.wantErr) {
+				t.Fatalf("event error = %v, want %v", event.Err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			req := event.Request
+			if event.UID != "event@example.com" || req.ICalUID != event.UID || req.Title != "Review" {
+				t.Errorf("UID %q, ICalUID %q, title %q", event.UID, req.ICalUID, req.Title)
+			}
+			if req.TimeZone != tt.wantZone {
+				t.Errorf("time zone = %q, want %q", req.TimeZone, tt.wantZone)
+			}
+			if tt.wantDates != [2]string{} {
+				if !req.AllDay || req.StartDate != tt.wantDates[0] || req.EndDate != tt.wantDates[1] {
+					t.Errorf("all day %v from %s to %s, want %v", req.AllDay, req.StartDate, req.EndDate, tt.wantDates)
+				}
+				return
+			}
+			if req.AllDay || !req.StartTime.Equal(tt.wantStart) || !req.EndTime.Equal(tt.wantEnd) {
+				t.Errorf("all day %v from %v to %v, want %v to %v", req.AllDay, req.StartTime, req.EndTime, tt.wantStart, tt.wantEnd)
+			}
+		})
+	}
+}
+
+func TestDecodeFile(t *testing.T) {
+	const event = "UID:event@example.com\nDTSTAMP:20250101T000000Z\nDTSTART:20250303T090000Z\nDTEND:20250303T100000Z"
+
+	tests := []struct {
+		name       string
+		data       string
+		timeZone   string
+		wantEvents int
+		wantErr    bool
+	}{
+		{name: "one calendar", data: calendarWith(event, event), timeZone: "UTC", wantEvents: 2},
+		{name: "several calendars", data: calendarWith(event) + calendarWith(event), timeZone: "UTC", wantEvents: 2},
+		{name: "no events", data: calendarWith(), timeZone: "UTC"},
+		{name: "default zone", data: calendarWith(event), wantEvents: 1},
+		{name: "malformed", data: "BEGIN:VCALENDAR\r\nVERSION\r\nEND:VCALENDAR\r\n", timeZone: "UTC", wantErr: true},
+		{name: "invalid zone", data: calendarWith(event), timeZone: "Local", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events, err := Decode(strings.NewReader(tt.data), tt.timeZone)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Decode() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(events) != tt.wantEvents {
+				t.Errorf("got %d events, want %d", len(events), tt.wantEvents)
+			}
+		})
+	}
+}
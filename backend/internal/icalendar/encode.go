@@ -0,0 +1,114 @@
+// Package icalendar converts appointments to and from iCalendar (RFC 5545) data
+package icalendar
+
+import (
+	"io"
+	"time"
+
+	"github.com/emersion/go-ical"
+	"github.com/pasDamola/schedule-management-system/internal/models"
+)
+
+const (
+	ProductID   = "-//Schedule Management System//Appointments//EN"
+	ContentType = "text/calendar; charset=utf-8"
+)
+
+// emptyCalendar is written instead of a VCALENDAR without events, which the
+// encoder refuses
+const emptyCalendar = "BEGIN:VCALENDAR\r\nVERSION:2.0\r\nPRODID:" + ProductID + "\r\nEND:VCALENDAR\r\n"
+
+// Encode writes appointments as a VCALENDAR named name
+func Encode(w io.Writer, name string, appointments []models.Appointment) error {
+	if len(appointments) == 0 {
+		_, err := io.WriteString(w, emptyCalendar)
+		return err
+	}
+
+	cal := NewCalendar(name)
+	cal.Children = append(cal.Children, Timezones(appointments)...)
+	for i := range appointments {
+		cal.Children = append(cal.Children, NewEvent(&appointments[i]).Component)
+	}
+
+	return ical.NewEncoder(w).Encode(cal)
+}
+
+// NewObject wraps a single appointment in its own VCALENDAR, as served for one
+// CalDAV resource
+func NewObject(appointment *models.Appointment) *ical.Calendar {
+	cal := NewCalendar("")
+	cal.Children = append(cal.Children, Timezones([]models.Appointment{*appointment})...)
+	cal.Children = append(cal.Children, NewEvent(appointment).Component)
+	return cal
+}
+
+// NewCalendar returns an empty VCALENDAR carrying the product ID and, if given, a display name
+func NewCalendar(name string) *ical.Calendar {
+	cal := ical.NewCalendar()
+	cal.Props.SetText(ical.PropVersion, "2.0")
+	cal.Props.SetText(ical.PropProductID, ProductID)
+	if name != "" {
+		cal.Props.SetText(ical.PropName, name)
+		// Widely read by clients predating RFC 7986 NAME
+		cal.Props.SetText("X-WR-CALNAME", name)
+		cal.Props.Get("X-WR-CALNAME").Params.Del(ical.ParamValue)
+	}
+	return cal
+}
+
+// NewEvent renders an appointment as a VEVENT. The UID is the appointment ID, or
+// the original UID of an imported event, so repeated exports update events in
+// place instead of duplicating them. Timed events outside UTC reference a
+// VTIMEZONE produced by Timezones.
+func NewEvent(appointment *models.Appointment) *ical.Event {
+	event := ical.NewEvent()
+	event.Props.SetText(ical.PropUID, EventUID(appointment))
+	event.Props.SetDateTime(ical.PropDateTimeStamp, appointment.UpdatedAt.UTC())
+	event.Props.SetDateTime(ical.PropCreated, appointment.CreatedAt.UTC())
+	event.Props.SetDateTime(ical.PropLastModified, appointment.UpdatedAt.UTC())
+	event.Props.SetText(ical.PropSummary, appointment.Title)
+
+	loc := appointmentLocation(appointment)
+	if appointment.AllDay {
+		// DTEND of a date-only event is exclusive, just like EndTime
+		event.Props.SetDate(ical.PropDateTimeStart, appointment.StartTime.In(loc))
+		event.Props.SetDate(ical.PropDateTimeEnd, appointment.EndTime.In(loc))
+	} else {
+		event.Props.SetDateTime(ical.PropDateTimeStart, appointment.StartTime.In(loc))
+		event.Props.SetDateTime(ical.PropDateTimeEnd, appointment.EndTime.In(loc))
+	}
+
+	switch {
+	case appointment.HoldExpiresAt != nil:
+		event.SetStatus(ical.EventTentative)
+	case appointment.Status == models.StatusCancelled:
+		event.SetStatus(ical.EventCancelled)
+	default:
+		event.SetStatus(ical.EventConfirmed)
+	}
+
+	if appointment.CalendarID != "" {
+		event.Props.SetText(ical.PropCategories, appointment.CalendarID)
+	}
+
+	return event
+}
+
+// appointmentLocation is the zone an appointment is rendered in; UTC is returned
+// as time.UTC so times are written with a Z suffix rather than a TZID
+func appointmentLocation(appointment *models.Appointment) *time.Location {
+	loc, err := models.LoadTimeZone(appointment.TimeZone)
+	if err != nil || loc.String() == "UTC" {
+		return time.UTC
+	}
+	return loc
+}
+
+// EventUID is the iCalendar UID an appointment is published under
+func EventUID(appointment *models.Appointment) string {
+	if appointment.ICalUID != "" {
+		return appointment.ICalUID
+	}
+	return appointment.ID.String()
+}
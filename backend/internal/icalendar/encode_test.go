@@ -0,0 +1,166 @@
+package icalendar
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/emersion/go-ical"
+	"github.com/google/uuid"
+	"github.com/pasDamola/schedule-management-system/internal/models"
+)
+
+func TestNewEvent(t *testing.T) {
+	id := uuid.New()
+	start := time.Date(2025, 3, 3, 9, 0, 0, 0, time.UTC)
+	holdExpiresAt := start.Add(-time.Hour)
+
+	tests := []struct {
+		name        string
+		appointment models.Appointment
+		wantUID     string
+		wantStart   string
+		wantEnd     string
+		wantTZID    string
+		wantDate    bool
+		wantStatus  ical.EventStatus
+		wantCal     string
+	}{
+		{
+			name:        "UTC",
+			appointment: models.Appointment{ID: id, StartTime: start, EndTime: start.Add(time.Hour), TimeZone: "UTC", Status: models.StatusScheduled},
+			wantUID:     id.String(),
+			wantStart:   "20250303T090000Z",
+			wantEnd:     "20250303T100000Z",
+			wantStatus:  ical.EventConfirmed,
+		},
+		{
+			name:        "named zone",
+			appointment: models.Appointment{ID: id, StartTime: start, EndTime: start.Add(time.Hour), TimeZone: "Europe/Berlin", CalendarID: "team"},
+			wantUID:     id.String(),
+			wantStart:   "20250303T100000",
+			wantEnd:     "20250303T110000",
+			wantTZID:    "Europe/Berlin",
+			wantStatus:  ical.EventConfirmed,
+			wantCal:     "team",
+		},
+		{
+			name:        "unknown zone",
+			appointment: models.Appointment{ID: id, StartTime: start, EndTime: start.Add(time.Hour), TimeZone: "Mars/Olympus"},
+			wantUID:     id.String(),
+			wantStart:   "20250303T090000Z",
+			wantEnd:     "20250303T100000Z",
+			wantStatus:  ical.EventConfirmed,
+		},
+		{
+			name: "all day",
+			appointment: models.Appointment{
+				ID: id, StartTime: time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC), EndTime: time.Date(2025, 3, 5, 0, 0, 0, 0, time.UTC),
+				TimeZone: "UTC", AllDay: true,
+			},
+			wantUID:    id.String(),
+			wantStart:  "20250303",
+			wantEnd:    "20250305",
+			wantDate:   true,
+			wantStatus: ical.EventConfirmed,
+		},
+		{
+			name:        "imported",
+			appointment: models.Appointment{ID: id, StartTime: start, EndTime: start.Add(time.Hour), ICalUID: "event@example.com"},
+			wantUID:     "event@example.com",
+			wantStart:   "20250303T090000Z",
+			wantEnd:     "20250303T100000Z",
+			wantStatus:  ical.EventConfirmed,
+		},
+		{
+			name:        "hold",
+			appointment: models.Appointment{ID: id, StartTime: start, EndTime: start.Add(time.Hour), HoldExpiresAt: &holdExpiresAt},
+			wantUID:     id.String(),
+			wantStart:   "20250303T090000Z",
+			wantEnd:     "20250303T100000Z",
+			wantStatus:  ical.EventTentative,
+		},
+		{
+			name:        "cancelled",
+			appointment: models.Appointment{ID: id, StartTime: start, EndTime: start.Add(time.Hour), Status: models.StatusCancelled},
+			wantUID:     id.String(),
+			wantStart:   "20250303T090000Z",
+			wantEnd:     "20250303T100000Z",
+			wantStatus:  ical.EventCancelled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := NewEvent(&tt.appointment)
+
+			if uid, _ := event.Props.Text(ical.PropUID); uid != tt.wantUID {
+				t.Errorf("UID = %q, want %q", uid, tt.wantUID)
+			}
+			for name, want := range map[string]string{ical.PropDateTimeStart: tt.wantStart, ical.PropDateTimeEnd: tt.wantEnd} {
+				prop := event.Props.Get(name)
+				if prop.Value != want {
+					t.Errorf("%s = %q, want %q", name, prop.Value, want)
+				}
+				if tzid := prop.Params.Get(ical.PropTimezoneID); tzid != tt.wantTZID {
+					t.Errorf("%s TZID = %q, want %q", name, tzid, tt.wantTZID)
+				}
+				if isDate := prop.ValueType() == ical.ValueDate; isDate != tt.wantDate {
+					t.Errorf("%s is a date = %v, want %v", name, isDate, tt.wantDate)
+				}
+			}
+			if status, _ := event.Status(); status != tt.wantStatus {
+				t.Errorf("STATUS = %q, want %q", status, tt.wantStatus)
+			}
+			if categories, _ := event.Props.Text(ical.PropCategories); categories != tt.wantCal {
+				t.Errorf("CATEGORIES = %q, want %q", categories, tt.wantCal)
+			}
+		})
+	}
+}
+
+// TestEncodeRoundTrip decodes an exported calendar back into the requests the
+// appointments were made from
+func TestEncodeRoundTrip(t *testing.T) {
+	start := time.Date(2025, 3, 3, 9, 0, 0, 0, time.UTC)
+	appointments := []models.Appointment{
+		{ID: uuid.New(), Title: "Review", StartTime: start, EndTime: start.Add(time.Hour), TimeZone: "Europe/Berlin"},
+		{
+			ID: uuid.New(), Title: "Offsite", StartTime: time.Date(2025, 3, 4, 0, 0, 0, 0, time.UTC), EndTime: time.Date(2025, 3, 6, 0, 0, 0, 0, time.UTC),
+			TimeZone: "UTC", AllDay: true,
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := Encode(&buf, "Team", appointments); err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+	events, err := Decode(&buf, "UTC")
+	if err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if len(events) != len(appointments) {
+		t.Fatalf("got %d events, want %d", len(events), len(appointments))
+	}
+
+	timed := events[0].Request
+	if events[0].Err != nil || timed.Title != "Review" || timed.TimeZone != "Europe/Berlin" ||
+		!timed.StartTime.Equal(appointments[0].StartTime) || !timed.EndTime.Equal(appointments[0].EndTime) {
+		t.Errorf("timed event = %+v (%v)", timed, events[0].Err)
+	}
+	allDay := events[1].Request
+	if events[1].Err != nil || !allDay.AllDay || allDay.StartDate != "2025-03-04" || allDay.EndDate != "2025-03-05" {
+		t.Errorf("all-day event = %+v (%v)", allDay, events[1].Err)
+	}
+}
+
+func TestEncodeEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Encode(&buf, "Team", nil); err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+	events, err := Decode(&buf, "UTC")
+	if err != nil || len(events) != 0 {
+		t.Errorf("Decode() = %d events, %v", len(events), err)
+	}
+}
@@ -0,0 +1,143 @@
+package icalendar
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/emersion/go-ical"
+	"github.com/pasDamola/schedule-management-system/internal/models"
+)
+
+// localTimeLayout is a DATE-TIME without zone, as used inside VTIMEZONE
+const localTimeLayout = "20060102T150405"
+
+// zoneSpan is the period a VTIMEZONE has to describe for one zone
+type zoneSpan struct {
+	loc        *time.Location
+	start, end time.Time
+}
+
+// Timezones returns a VTIMEZONE for every zone referenced by a timed appointment,
+// describing the offset changes between the earliest and latest of them
+func Timezones(appointments []models.Appointment) []*ical.Component {
+	var order []string
+	spans := make(map[string]*zoneSpan)
+	for i := range appointments {
+		appointment := &appointments[i]
+		loc := appointmentLocation(appointment)
+		if appointment.AllDay || loc == time.UTC {
+			continue
+		}
+
+		span, ok := spans[loc.String()]
+		if !ok {
+			span = &zoneSpan{loc: loc, start: appointment.StartTime, end: appointment.EndTime}
+			spans[loc.String()] = span
+			order = append(order, loc.String())
+		}
+		if appointment.StartTime.Before(span.start) {
+			span.start = appointment.StartTime
+		}
+		if appointment.EndTime.After(span.end) {
+			span.end = appointment.EndTime
+		}
+	}
+
+	components := make([]*ical.Component, 0, len(order))
+	for _, name := range order {
+		components = append(components, NewTimezone(spans[name].loc, spans[name].start, spans[name].end))
+	}
+	return components
+}
+
+// NewTimezone builds a VTIMEZONE for loc covering start to end, with one
+// observance for the offset in force at start and one per transition after it
+func NewTimezone(loc *time.Location, start, end time.Time) *ical.Component {
+	tz := ical.NewComponent(ical.CompTimezone)
+	tz.Props.SetText(ical.PropTimezoneID, loc.String())
+
+	// Begin a day early so events starting just after midnight are covered
+	at := start.Add(-24 * time.Hour).In(loc)
+	name, offset := at.Zone()
+	tz.Children = append(tz.Children, observance(at, name, offset, offset, at.IsDST()))
+
+	for _, transition := range zoneTransitions(loc, at, end) {
+		name, toOffset := transition.Zone()
+		_, fromOffset := transition.Add(-time.Second).Zone()
+		tz.Children = append(tz.Children, observance(transition, name, fromOffset, toOffset, transition.IsDST()))
+	}
+
+	return tz
+}
+
+// observance renders a STANDARD or DAYLIGHT sub-component. DTSTART is the onset
+// in local time before the change, as RFC 5545 requires.
+func observance(onset time.Time, name string, fromOffset, toOffset int, dst bool) *ical.Component {
+	kind := ical.CompTimezoneStandard
+	if dst {
+		kind = ical.CompTimezoneDaylight
+	}
+
+	component := ical.NewComponent(kind)
+	setRaw(component.Props, ical.PropDateTimeStart, onset.UTC().Add(time.Duration(fromOffset)*time.Second).Format(localTimeLayout))
+	setRaw(component.Props, ical.PropTimezoneOffsetFrom, formatOffset(fromOffset))
+	setRaw(component.Props, ical.PropTimezoneOffsetTo, formatOffset(toOffset))
+	if name != "" {
+		component.Props.SetText(ical.PropTimezoneName, name)
+	}
+	return component
+}
+
+// zoneTransitions finds the instants between from and to at which loc changes
+// its UTC offset, to the second
+func zoneTransitions(loc *time.Location, from, to time.Time) []time.Time {
+	const step = 24 * time.Hour
+
+	var transitions []time.Time
+	prev := from.Truncate(time.Second).In(loc)
+	for prev.Before(to) {
+		next := prev.Add(step)
+		if _, prevOffset := prev.Zone(); prevOffset != offsetAt(next) {
+			// Narrow down to the first second in the new offset
+			lo, hi := prev, next
+			for hi.Sub(lo) > time.Second {
+				mid := lo.Add(hi.Sub(lo) / 2).Truncate(time.Second)
+				if offsetAt(mid) == prevOffset {
+					lo = mid
+				} else {
+					hi = mid
+				}
+			}
+			transitions = append(transitions, hi)
+		}
+		prev = next
+	}
+	return transitions
+}
+
+func offsetAt(t time.Time) int {
+	_, offset := t.Zone()
+	return offset
+}
+
+// formatOffset renders a UTC offset in seconds as +HHMM, or +HHMMSS when needed
+func formatOffset(offset int) string {
+	sign := '+'
+	if offset < 0 {
+		sign = '-'
+		offset = -offset
+	}
+	hours, minutes, seconds := offset/3600, offset%3600/60, offset%60
+	if seconds != 0 {
+		return fmt.Sprintf("%c%02d%02d%02d", sign, hours, minutes, seconds)
+	}
+	return fmt.Sprintf("%c%02d%02d", sign, hours, minutes)
+}
+
+// setRaw sets a property value verbatim, without the VALUE parameter SetText adds
+// to properties whose default type is not TEXT
+func setRaw(props ical.Props, name, value string) {
+	prop := ical.NewProp(name)
+	prop.Value = value
+	props.Set(prop)
+}
@@ -0,0 +1,68 @@
+// Package metrics defines the Prometheus metrics of the server and serves them
+// from the default registry, next to the Go runtime and process metrics.
+package metrics
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "schedule"
+
+var (
+	// RPCDuration observes how long each RPC took, streams included
+	RPCDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "handling_seconds",
+		Help:      "Time taken to handle RPCs, by service, method and type.",
+		Buckets:   []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10},
+	}, []string{"grpc_service", "grpc_method", "grpc_type"})
+
+	// RPCHandled counts finished RPCs by their status code
+	RPCHandled = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "handled_total",
+		Help:      "RPCs completed, by service, method, type and status code.",
+	}, []string{"grpc_service", "grpc_method", "grpc_type", "grpc_code"})
+
+	// ConflictsRejected counts writes refused because the slot was taken
+	ConflictsRejected = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "appointments",
+		Name:      "conflicts_rejected_total",
+		Help:      "Appointment writes rejected because they overlap another appointment, by operation.",
+	}, []string{"operation"})
+
+	// StreamSubscribers is the number of open StreamAppointments subscriptions
+	StreamSubscribers = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "stream",
+		Name:      "subscribers",
+		Help:      "Current StreamAppointments subscribers.",
+	})
+
+	// StreamEventsDropped counts events not delivered because a subscriber was too slow
+	StreamEventsDropped = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "stream",
+		Name:      "events_dropped_total",
+		Help:      "Appointment events dropped because a subscriber's buffer was full.",
+	})
+)
+
+// RegisterDB exports the connection pool statistics of db
+func RegisterDB(db *sql.DB, name string) {
+	prometheus.MustRegister(collectors.NewDBStatsCollector(db, name))
+}
+
+// Handler serves every registered metric in the Prometheus text format
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
@@ -0,0 +1,11 @@
+package models
+
+import "errors"
+
+var ErrPermissionDenied = errors.New("permission denied")
+
+// AccessFilter matches the appointments owned by Owner or in one of CalendarIDs
+type AccessFilter struct {
+	Owner       string
+	CalendarIDs []string
+}
@@ -0,0 +1,60 @@
+package models
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrAPIKeyNotFound    = errors.New("API key not found")
+	ErrInvalidAPIKey     = errors.New("invalid API key")
+	ErrInvalidAPIKeyName = errors.New("invalid API key name")
+	ErrInvalidScope      = errors.New("invalid scope")
+)
+
+// Scopes an API key may be granted. Each scope is checked on its own, so a key
+// that writes and reads needs both.
+const (
+	ScopeAppointmentsRead  = "appointments:read"
+	ScopeAppointmentsWrite = "appointments:write"
+)
+
+// APIKey lets a service call the API without an interactive login. Key is only
+// known when the key is created; afterwards just its hash is kept, and Prefix
+// tells keys apart.
+type APIKey struct {
+	ID         uuid.UUID  `json:"id" db:"id"`
+	Name       string     `json:"name" db:"name"`
+	Scopes     []string   `json:"scopes" db:"scopes"`
+	Prefix     string     `json:"prefix" db:"prefix"`
+	Key        string     `json:"key,omitempty"`
+	CreatedBy  string     `json:"created_by" db:"created_by"`
+	CreatedAt  time.Time  `json:"created_at" db:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty" db:"last_used_at"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty" db:"revoked_at"`
+	TenantID   string     `json:"-" db:"tenant_id"`
+}
+
+type CreateAPIKeyRequest struct {
+	Name   string   `json:"name" validate:"required,max=100"`
+	Scopes []string `json:"scopes" validate:"required"`
+}
+
+func (req *CreateAPIKeyRequest) Validate() error {
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Name == "" || len(req.Name) > 100 {
+		return ErrInvalidAPIKeyName
+	}
+	if len(req.Scopes) == 0 {
+		return ErrInvalidScope
+	}
+	for _, scope := range req.Scopes {
+		if scope != ScopeAppointmentsRead && scope != ScopeAppointmentsWrite {
+			return ErrInvalidScope
+		}
+	}
+	return nil
+}
@@ -16,21 +16,97 @@ var (
 	ErrInvalidTimeRange    = errors.New("invalid time range: start time must be before end time")
 	ErrInvalidID           = errors.New("invalid ID: ID cannot be empty")
 	ErrPastTime            = errors.New("invalid time: cannot schedule appointments in the past")
+	ErrInvalidCalendarID   = errors.New("invalid calendar ID: calendar ID must be at most 100 characters")
+	ErrInvalidTimeZone     = errors.New("invalid time zone: must be an IANA time zone name such as Europe/Berlin")
+	ErrInvalidDate         = errors.New("invalid date: all-day events need start and end dates in YYYY-MM-DD format with start not after end")
+	ErrNotAHold            = errors.New("appointment is not a tentative hold")
+	ErrHoldExpired         = errors.New("hold has expired")
+	ErrInvalidHoldTTL      = errors.New("invalid hold TTL: exceeds the maximum hold duration")
 )
 
+// Conflict is an appointment that a write would overlap
+type Conflict struct {
+	ID        uuid.UUID `json:"id"`
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+}
+
+// ConflictError is an ErrAppointmentConflict naming the appointments in the way
+type ConflictError struct {
+	Conflicts []Conflict
+}
+
+func (e *ConflictError) Error() string {
+	return ErrAppointmentConflict.Error()
+}
+
+func (e *ConflictError) Unwrap() error {
+	return ErrAppointmentConflict
+}
+
+// DateLayout is the format of all-day start and end dates
+const DateLayout = "2006-01-02"
+
+// DefaultCalendarID is the calendar appointments belong to when none is given
+const DefaultCalendarID = "default"
+
+// DefaultTimeZone is the organizer time zone assumed when none is given
+const DefaultTimeZone = "UTC"
+
 type Appointment struct {
-	ID        uuid.UUID `json:"id" db:"id"`
-	Title     string    `json:"title" db:"title"`
-	StartTime time.Time `json:"start_time" db:"start_time"`
-	EndTime   time.Time `json:"end_time" db:"end_time"`
-	CreatedAt time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+	ID         uuid.UUID `json:"id" db:"id"`
+	Title      string    `json:"title" db:"title"`
+	StartTime  time.Time `json:"start_time" db:"start_time"`
+	EndTime    time.Time `json:"end_time" db:"end_time"`
+	CalendarID string    `json:"calendar_id" db:"calendar_id"`
+	TimeZone   string    `json:"time_zone" db:"time_zone"`
+	AllDay     bool      `json:"all_day" db:"all_day"`
+	CreatedAt  time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at" db:"updated_at"`
+	// HoldExpiresAt is set while the appointment is a tentative hold
+	HoldExpiresAt      *time.Time        `json:"hold_expires_at,omitempty" db:"hold_expires_at"`
+	Status             AppointmentStatus `json:"status" db:"status"`
+	CancellationReason string            `json:"cancellation_reason,omitempty" db:"cancellation_reason"`
+	CancelledAt        *time.Time        `json:"cancelled_at,omitempty" db:"cancelled_at"`
+	// DeletedAt is set while the appointment is in the trash
+	DeletedAt *time.Time `json:"deleted_at,omitempty" db:"deleted_at"`
+	// ICalUID is the UID of the iCalendar event the appointment came from, if any
+	ICalUID string `json:"ical_uid,omitempty" db:"ical_uid"`
+	// Owner may manage the appointment besides the calendar's editors
+	Owner     string `json:"owner" db:"owner"`
+	CreatedBy string `json:"created_by" db:"created_by"`
+	// TenantID is the tenant the appointment belongs to; tenants never see each other's data
+	TenantID string `json:"tenant_id" db:"tenant_id"`
 }
 
 type CreateAppointmentRequest struct {
-	Title     string    `json:"title" validate:"required,min=1,max=255"`
-	StartTime time.Time `json:"start_time" validate:"required"`
-	EndTime   time.Time `json:"end_time" validate:"required"`
+	Title      string    `json:"title" validate:"required,min=1,max=255"`
+	StartTime  time.Time `json:"start_time" validate:"required"`
+	EndTime    time.Time `json:"end_time" validate:"required"`
+	CalendarID string    `json:"calendar_id" validate:"max=100"`
+	TimeZone   string    `json:"time_zone"`
+	// All-day events are given as inclusive dates in TimeZone instead of times
+	AllDay    bool   `json:"all_day"`
+	StartDate string `json:"start_date"`
+	EndDate   string `json:"end_date"`
+	// HoldExpiresAt creates a tentative hold instead of a regular appointment
+	HoldExpiresAt *time.Time `json:"hold_expires_at,omitempty"`
+	ICalUID       string     `json:"ical_uid,omitempty"`
+}
+
+// HoldSlotRequest reserves a slot for TTL while a booking is completed
+type HoldSlotRequest struct {
+	Title      string        `json:"title"`
+	StartTime  time.Time     `json:"start_time" validate:"required"`
+	EndTime    time.Time     `json:"end_time" validate:"required"`
+	CalendarID string        `json:"calendar_id" validate:"max=100"`
+	TimeZone   string        `json:"time_zone"`
+	TTL        time.Duration `json:"ttl"`
+}
+
+type ConfirmHoldRequest struct {
+	ID    uuid.UUID `json:"id" validate:"required"`
+	Title string    `json:"title"`
 }
 
 type UpdateAppointmentRequest struct {
@@ -38,14 +114,22 @@ type UpdateAppointmentRequest struct {
 	Title     string    `json:"title" validate:"required,min=1,max=255"`
 	StartTime time.Time `json:"start_time" validate:"required"`
 	EndTime   time.Time `json:"end_time" validate:"required"`
+	TimeZone  string    `json:"time_zone"`
 }
 
 type ListAppointmentsRequest struct {
-	Page      int       `json:"page" validate:"min=1"`
-	Limit     int       `json:"limit" validate:"min=1,max=100"`
-	Search    string    `json:"search"`
-	StartDate time.Time `json:"start_date"`
-	EndDate   time.Time `json:"end_date"`
+	Page       int       `json:"page" validate:"min=1"`
+	Limit      int       `json:"limit" validate:"min=1,max=100"`
+	Search     string    `json:"search"`
+	StartDate  time.Time `json:"start_date"`
+	EndDate    time.Time `json:"end_date"`
+	CalendarID string    `json:"calendar_id"`
+	// Statuses restricts results to the given statuses; empty means all
+	Statuses []AppointmentStatus `json:"statuses"`
+	// Deleted lists the trash instead of live appointments
+	Deleted bool `json:"deleted"`
+	// Visible restricts results to what the caller may see; nil means everything
+	Visible *AccessFilter `json:"-"`
 }
 
 type ListAppointmentsResponse struct {
@@ -66,7 +150,35 @@ func (req *CreateAppointmentRequest) Validate() error {
 	if req.StartTime.After(req.EndTime) || req.StartTime.Equal(req.EndTime) {
 		return ErrInvalidTimeRange
 	}
-	if req.StartTime.Before(time.Now()) {
+	if len(req.CalendarID) > 100 {
+		return ErrInvalidCalendarID
+	}
+	if _, err := LoadTimeZone(req.TimeZone); err != nil {
+		return err
+	}
+	if len(req.ICalUID) > MaxICalUIDLength {
+		return ErrInvalidICalUID
+	}
+	return nil
+}
+
+// ValidateNotPast rejects a request starting in the past. Only new bookings are
+// checked; imported and replaced appointments may lie in the past.
+func (req *CreateAppointmentRequest) ValidateNotPast() error {
+	if !req.AllDay {
+		if req.StartTime.Before(time.Now()) {
+			return ErrPastTime
+		}
+		return nil
+	}
+
+	// All-day events may start today even though midnight has passed
+	loc, err := LoadTimeZone(req.TimeZone)
+	if err != nil {
+		return err
+	}
+	now := time.Now().In(loc)
+	if req.StartTime.Before(time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)) {
 		return ErrPastTime
 	}
 	return nil
@@ -85,5 +197,53 @@ func (req *UpdateAppointmentRequest) Validate() error {
 	if req.StartTime.After(req.EndTime) || req.StartTime.Equal(req.EndTime) {
 		return ErrInvalidTimeRange
 	}
+	if _, err := LoadTimeZone(req.TimeZone); err != nil {
+		return err
+	}
 	return nil
+}
+
+// ResolveAllDay sets StartTime and EndTime of an all-day request from its
+// inclusive dates: midnight of StartDate to midnight after EndDate in TimeZone
+func (req *CreateAppointmentRequest) ResolveAllDay() error {
+	loc, err := LoadTimeZone(req.TimeZone)
+	if err != nil {
+		return err
+	}
+	startDate, err := time.ParseInLocation(DateLayout, req.StartDate, loc)
+	if err != nil {
+		return ErrInvalidDate
+	}
+	endDate, err := time.ParseInLocation(DateLayout, req.EndDate, loc)
+	if err != nil || endDate.Before(startDate) {
+		return ErrInvalidDate
+	}
+	req.StartTime = startDate
+	req.EndTime = endDate.AddDate(0, 0, 1)
+	return nil
+}
+
+// AllDayDates returns the inclusive start and end dates of an all-day appointment in its time zone
+func (a *Appointment) AllDayDates() (string, string) {
+	loc, err := LoadTimeZone(a.TimeZone)
+	if err != nil {
+		loc = time.UTC
+	}
+	return a.StartTime.In(loc).Format(DateLayout), a.EndTime.In(loc).AddDate(0, 0, -1).Format(DateLayout)
+}
+
+// LoadTimeZone resolves an IANA time zone name, treating an empty name as DefaultTimeZone
+func LoadTimeZone(name string) (*time.Location, error) {
+	if name == "" {
+		name = DefaultTimeZone
+	}
+	// "Local" depends on the server host and is not an IANA name
+	if name == "Local" {
+		return nil, ErrInvalidTimeZone
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, ErrInvalidTimeZone
+	}
+	return loc, nil
 }
\ No newline at end of file
@@ -0,0 +1,74 @@
+package models
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCreateAppointmentRequestValidate(t *testing.T) {
+	start := time.Now().Add(-24 * time.Hour)
+
+	tests := []struct {
+		name   string
+		modify func(req *CreateAppointmentRequest)
+		want   error
+	}{
+		{name: "valid"},
+		{name: "past times are allowed", modify: func(req *CreateAppointmentRequest) {
+			req.StartTime = start.AddDate(-1, 0, 0)
+			req.EndTime = req.StartTime.Add(time.Hour)
+		}},
+		{name: "missing title", modify: func(req *CreateAppointmentRequest) { req.Title = "" }, want: ErrInvalidTitle},
+		{name: "missing end", modify: func(req *CreateAppointmentRequest) { req.EndTime = time.Time{} }, want: ErrInvalidTime},
+		{name: "end before start", modify: func(req *CreateAppointmentRequest) { req.EndTime = start.Add(-time.Minute) }, want: ErrInvalidTimeRange},
+		{name: "long calendar ID", modify: func(req *CreateAppointmentRequest) { req.CalendarID = strings.Repeat("c", 101) }, want: ErrInvalidCalendarID},
+		{name: "unknown time zone", modify: func(req *CreateAppointmentRequest) { req.TimeZone = "Mars/Olympus" }, want: ErrInvalidTimeZone},
+		{name: "long UID", modify: func(req *CreateAppointmentRequest) { req.ICalUID = strings.Repeat("u", MaxICalUIDLength+1) }, want: ErrInvalidICalUID},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &CreateAppointmentRequest{Title: "Review", StartTime: start, EndTime: start.Add(time.Hour)}
+			if tt.modify != nil {
+				tt.modify(req)
+			}
+			if err := req.Validate(); !errors.Is(err, tt.want) {
+				t.Errorf("Validate() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateNotPast(t *testing.T) {
+	now := time.Now()
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+	today := time.Date(now.In(tokyo).Year(), now.In(tokyo).Month(), now.In(tokyo).Day(), 0, 0, 0, 0, tokyo)
+
+	tests := []struct {
+		name string
+		req  CreateAppointmentRequest
+		want error
+	}{
+		{name: "future", req: CreateAppointmentRequest{StartTime: now.Add(time.Minute)}},
+		{name: "past", req: CreateAppointmentRequest{StartTime: now.Add(-time.Minute)}, want: ErrPastTime},
+		{name: "all-day today", req: CreateAppointmentRequest{AllDay: true, TimeZone: "Asia/Tokyo", StartTime: today}},
+		{
+			name: "all-day yesterday",
+			req:  CreateAppointmentRequest{AllDay: true, TimeZone: "Asia/Tokyo", StartTime: today.AddDate(0, 0, -1)},
+			want: ErrPastTime,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.req.ValidateNotPast(); !errors.Is(err, tt.want) {
+				t.Errorf("ValidateNotPast() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
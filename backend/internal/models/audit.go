@@ -0,0 +1,68 @@
+package models
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrInvalidAuditAction = errors.New("invalid audit action: must be one of created, updated, deleted, restored or purged")
+
+type AuditAction string
+
+const (
+	AuditActionCreated  AuditAction = "created"
+	AuditActionUpdated  AuditAction = "updated"
+	AuditActionDeleted  AuditAction = "deleted"
+	AuditActionRestored AuditAction = "restored"
+	AuditActionPurged   AuditAction = "purged"
+)
+
+func (a AuditAction) IsValid() bool {
+	switch a {
+	case AuditActionCreated, AuditActionUpdated, AuditActionDeleted, AuditActionRestored, AuditActionPurged:
+		return true
+	}
+	return false
+}
+
+// AuditEvent records one change to an appointment with snapshots either side of it.
+// Before is nil for creations and After is nil for purges.
+type AuditEvent struct {
+	ID            int64        `json:"id" db:"id"`
+	AppointmentID uuid.UUID    `json:"appointment_id" db:"appointment_id"`
+	Action        AuditAction  `json:"action" db:"action"`
+	Actor         string       `json:"actor" db:"actor"`
+	RequestID     string       `json:"request_id" db:"request_id"`
+	OccurredAt    time.Time    `json:"occurred_at" db:"occurred_at"`
+	Before        *Appointment `json:"before,omitempty" db:"before"`
+	After         *Appointment `json:"after,omitempty" db:"after"`
+}
+
+type ListAuditEventsRequest struct {
+	Page          int         `json:"page" validate:"min=1"`
+	Limit         int         `json:"limit" validate:"min=1,max=100"`
+	AppointmentID uuid.UUID   `json:"appointment_id"`
+	Actor         string      `json:"actor"`
+	Action        AuditAction `json:"action"`
+	StartTime     time.Time   `json:"start_time"`
+	EndTime       time.Time   `json:"end_time"`
+}
+
+type ListAuditEventsResponse struct {
+	Events []AuditEvent `json:"events"`
+	Total  int          `json:"total"`
+	Page   int          `json:"page"`
+	Limit  int          `json:"limit"`
+}
+
+func (req *ListAuditEventsRequest) Validate() error {
+	if req.Action != "" && !req.Action.IsValid() {
+		return ErrInvalidAuditAction
+	}
+	if !req.StartTime.IsZero() && !req.EndTime.IsZero() && !req.EndTime.After(req.StartTime) {
+		return ErrInvalidTimeRange
+	}
+	return nil
+}
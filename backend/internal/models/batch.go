@@ -0,0 +1,54 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// MaxBatchSize is the most items a single batch request may contain
+const MaxBatchSize = 500
+
+var ErrInvalidBatchSize = errors.New("invalid batch size: must contain between 1 and 500 items")
+
+// BatchMode decides what happens when an item in a batch fails
+type BatchMode int
+
+const (
+	// BatchModeAtomic applies every item in one transaction or none at all
+	BatchModeAtomic BatchMode = iota
+	// BatchModeBestEffort applies each item on its own and reports per-item results
+	BatchModeBestEffort
+)
+
+// BatchItemResult is the outcome of one item in a batch, identified by its position
+// in the request. Err is nil when the item succeeded.
+type BatchItemResult struct {
+	Index       int
+	ID          uuid.UUID
+	Appointment *Appointment
+	Err         error
+}
+
+// BatchItemError reports which item caused an atomic batch to be rolled back
+type BatchItemError struct {
+	Index int
+	Err   error
+}
+
+func (e *BatchItemError) Error() string {
+	return fmt.Sprintf("item %d: %v", e.Index, e.Err)
+}
+
+func (e *BatchItemError) Unwrap() error {
+	return e.Err
+}
+
+// ValidateBatchSize checks that a batch has between 1 and MaxBatchSize items
+func ValidateBatchSize(size int) error {
+	if size < 1 || size > MaxBatchSize {
+		return ErrInvalidBatchSize
+	}
+	return nil
+}
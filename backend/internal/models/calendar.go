@@ -0,0 +1,22 @@
+package models
+
+import "time"
+
+// CalendarSettings holds per-calendar scheduling policy
+type CalendarSettings struct {
+	CalendarID       string    `json:"calendar_id" db:"calendar_id"`
+	AllDayBlocksTime bool      `json:"all_day_blocks_time" db:"all_day_blocks_time"`
+	UpdatedAt        time.Time `json:"updated_at" db:"updated_at"`
+}
+
+type UpdateCalendarSettingsRequest struct {
+	CalendarID       string `json:"calendar_id" validate:"max=100"`
+	AllDayBlocksTime bool   `json:"all_day_blocks_time"`
+}
+
+func (req *UpdateCalendarSettingsRequest) Validate() error {
+	if len(req.CalendarID) > 100 {
+		return ErrInvalidCalendarID
+	}
+	return nil
+}
@@ -0,0 +1,8 @@
+package models
+
+import "errors"
+
+// MaxExportSize is the most appointments a single export may contain
+const MaxExportSize = 5000
+
+var ErrExportTooLarge = errors.New("export too large: narrow the filter to at most 5000 appointments")
@@ -0,0 +1,33 @@
+package models
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrFeedNotFound = errors.New("feed not found")
+
+// FeedToken grants read access to a calendar's iCalendar feed. Token and URL are
+// only known when the token is created or rotated; afterwards just its hash is kept.
+type FeedToken struct {
+	ID         uuid.UUID  `json:"id" db:"id"`
+	CalendarID string     `json:"calendar_id" db:"calendar_id"`
+	Token      string     `json:"token,omitempty"`
+	URL        string     `json:"url,omitempty"`
+	CreatedAt  time.Time  `json:"created_at" db:"created_at"`
+	RotatedAt  *time.Time `json:"rotated_at,omitempty" db:"rotated_at"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty" db:"revoked_at"`
+	TenantID   string     `json:"-" db:"tenant_id"`
+}
+
+// Feed describes the current contents of a calendar feed. ETag changes whenever
+// an appointment in the feed is created, changed, deleted or expires.
+type Feed struct {
+	TenantID     string
+	CalendarID   string
+	Since        time.Time
+	ETag         string
+	LastModified time.Time
+}
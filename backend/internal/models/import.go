@@ -0,0 +1,78 @@
+package models
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxImportSize is the largest import upload accepted, in bytes
+const MaxImportSize = 10 << 20
+
+// MaxICalUIDLength matches the ical_uid column
+const MaxICalUIDLength = 255
+
+var (
+	ErrInvalidICS      = errors.New("invalid iCalendar data")
+	ErrImportTooLarge  = errors.New("import too large: uploads are limited to 10 MiB")
+	ErrInvalidICalUID  = errors.New("invalid iCalendar UID: UID must be at most 255 characters")
+	ErrImportOptions   = errors.New("invalid import: options must be sent before the iCalendar data")
+	ErrUnsupportedICal = errors.New("unsupported event: recurring and cancelled events are not imported")
+)
+
+// ImportOptions control how an iCalendar file is imported
+type ImportOptions struct {
+	CalendarID string `json:"calendar_id"`
+	// TimeZone applies to floating times, which carry no zone of their own
+	TimeZone string `json:"time_zone"`
+	// DryRun reports what would happen without creating anything
+	DryRun bool `json:"dry_run"`
+}
+
+func (opts *ImportOptions) Validate() error {
+	if len(opts.CalendarID) > 100 {
+		return ErrInvalidCalendarID
+	}
+	if _, err := LoadTimeZone(opts.TimeZone); err != nil {
+		return err
+	}
+	return nil
+}
+
+type ImportOutcome string
+
+const (
+	ImportOutcomeImported  ImportOutcome = "imported"
+	ImportOutcomeDuplicate ImportOutcome = "duplicate"
+	ImportOutcomeConflict  ImportOutcome = "conflict"
+	ImportOutcomeFailed    ImportOutcome = "failed"
+)
+
+// ImportEventResult is what happened to one VEVENT. AppointmentID is only set
+// for events actually imported, or for duplicates of an existing appointment.
+type ImportEventResult struct {
+	UID           string        `json:"uid"`
+	Title         string        `json:"title"`
+	StartTime     time.Time     `json:"start_time"`
+	EndTime       time.Time     `json:"end_time"`
+	Outcome       ImportOutcome `json:"outcome"`
+	AppointmentID uuid.UUID     `json:"appointment_id"`
+	Err           error         `json:"-"`
+}
+
+type ImportReport struct {
+	DryRun  bool                `json:"dry_run"`
+	Results []ImportEventResult `json:"results"`
+}
+
+// Count returns how many events ended with outcome
+func (r *ImportReport) Count(outcome ImportOutcome) int {
+	count := 0
+	for _, result := range r.Results {
+		if result.Outcome == outcome {
+			count++
+		}
+	}
+	return count
+}
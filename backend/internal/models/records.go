@@ -0,0 +1,87 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidRecordFormat = errors.New("invalid format: must be csv or ndjson")
+	ErrInvalidCSVHeader    = errors.New("invalid CSV header: the first row must name the columns and include title")
+)
+
+// RecordFormat is a spreadsheet-friendly encoding of appointments, one per row
+type RecordFormat string
+
+const (
+	RecordFormatCSV    RecordFormat = "csv"
+	RecordFormatNDJSON RecordFormat = "ndjson"
+)
+
+func (f RecordFormat) IsValid() bool {
+	return f == RecordFormatCSV || f == RecordFormatNDJSON
+}
+
+// RecordError reports a field of an imported row that could not be parsed
+type RecordError struct {
+	Field  string
+	Reason string
+}
+
+func (e *RecordError) Error() string {
+	return fmt.Sprintf("invalid %s: %s", e.Field, e.Reason)
+}
+
+// RecordImportOptions control how a CSV or NDJSON file is imported
+type RecordImportOptions struct {
+	Format RecordFormat `json:"format"`
+	// CalendarID and TimeZone apply to rows that leave them empty
+	CalendarID string    `json:"calendar_id"`
+	TimeZone   string    `json:"time_zone"`
+	Mode       BatchMode `json:"mode"`
+	// DryRun reports what would happen without creating anything
+	DryRun bool `json:"dry_run"`
+}
+
+func (opts *RecordImportOptions) Validate() error {
+	if !opts.Format.IsValid() {
+		return ErrInvalidRecordFormat
+	}
+	if len(opts.CalendarID) > 100 {
+		return ErrInvalidCalendarID
+	}
+	if _, err := LoadTimeZone(opts.TimeZone); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RecordResult is what happened to one row, identified by the line it starts on.
+// ID is only set for rows actually created; Err is nil when the row succeeded.
+type RecordResult struct {
+	Line int       `json:"line"`
+	ID   uuid.UUID `json:"id"`
+	Err  error     `json:"-"`
+}
+
+// RecordImportReport lists every row of an import. Committed is false when nothing
+// was written, either because of a dry run or because an atomic import failed.
+type RecordImportReport struct {
+	Mode      BatchMode      `json:"mode"`
+	DryRun    bool           `json:"dry_run"`
+	Committed bool           `json:"committed"`
+	Results   []RecordResult `json:"results"`
+}
+
+// Failed returns how many rows have an error
+func (r *RecordImportReport) Failed() int {
+	failed := 0
+	for _, result := range r.Results {
+		if result.Err != nil {
+			failed++
+		}
+	}
+	return failed
+}
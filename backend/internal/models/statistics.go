@@ -0,0 +1,79 @@
+package models
+
+import (
+	"errors"
+	"time"
+)
+
+// Statistics errors
+var (
+	ErrInvalidStatisticsWindow = errors.New("invalid statistics window: start date must be before end date and at most 366 days apart")
+	ErrInvalidGroupBy          = errors.New("invalid group by: must be one of none, day, week or calendar")
+)
+
+// MaxStatisticsWindow bounds the period a single statistics query may cover
+const MaxStatisticsWindow = 366 * 24 * time.Hour
+
+type StatisticsGroupBy string
+
+const (
+	GroupByNone     StatisticsGroupBy = ""
+	GroupByDay      StatisticsGroupBy = "day"
+	GroupByWeek     StatisticsGroupBy = "week"
+	GroupByCalendar StatisticsGroupBy = "calendar"
+)
+
+type StatisticsRequest struct {
+	StartDate time.Time         `json:"start_date" validate:"required"`
+	EndDate   time.Time         `json:"end_date" validate:"required"`
+	GroupBy   StatisticsGroupBy `json:"group_by"`
+	// TimeZone sets day boundaries, peak hours and working hours; when empty the
+	// configured workday time zone is used
+	TimeZone string `json:"time_zone"`
+}
+
+// AppointmentStatistics aggregates the appointments overlapping a period.
+// Booked hours only count the part of each appointment inside the window.
+type AppointmentStatistics struct {
+	Key                    string    `json:"key"`
+	PeriodStart            time.Time `json:"period_start"`
+	PeriodEnd              time.Time `json:"period_end"`
+	AppointmentCount       int       `json:"appointment_count"`
+	BookedHours            float64   `json:"booked_hours"`
+	AvailableHours         float64   `json:"available_hours"`
+	Utilization            float64   `json:"utilization"`
+	AverageDurationMinutes float64   `json:"average_duration_minutes"`
+	CancellationCount      int       `json:"cancellation_count"`
+}
+
+type HourCount struct {
+	Hour             int `json:"hour"`
+	AppointmentCount int `json:"appointment_count"`
+}
+
+type StatisticsResponse struct {
+	Summary   AppointmentStatistics   `json:"summary"`
+	Groups    []AppointmentStatistics `json:"groups"`
+	PeakHours []HourCount             `json:"peak_hours"`
+}
+
+func (req *StatisticsRequest) Validate() error {
+	if req.StartDate.IsZero() || req.EndDate.IsZero() {
+		return ErrInvalidStatisticsWindow
+	}
+	if !req.StartDate.Before(req.EndDate) {
+		return ErrInvalidStatisticsWindow
+	}
+	if req.EndDate.Sub(req.StartDate) > MaxStatisticsWindow {
+		return ErrInvalidStatisticsWindow
+	}
+	switch req.GroupBy {
+	case GroupByNone, GroupByDay, GroupByWeek, GroupByCalendar:
+	default:
+		return ErrInvalidGroupBy
+	}
+	if _, err := LoadTimeZone(req.TimeZone); err != nil {
+		return err
+	}
+	return nil
+}
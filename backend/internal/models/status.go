@@ -0,0 +1,71 @@
+package models
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+// Status errors
+var (
+	ErrInvalidStatus           = errors.New("invalid status: must be one of scheduled, confirmed, cancelled, completed or no_show")
+	ErrInvalidStatusTransition = errors.New("invalid status transition")
+	ErrInvalidCancelReason     = errors.New("invalid cancellation reason: reason must be at most 1000 characters")
+)
+
+// MaxCancellationReasonLength bounds the free-text reason stored with a cancellation
+const MaxCancellationReasonLength = 1000
+
+type AppointmentStatus string
+
+const (
+	StatusScheduled AppointmentStatus = "scheduled"
+	StatusConfirmed AppointmentStatus = "confirmed"
+	StatusCancelled AppointmentStatus = "cancelled"
+	StatusCompleted AppointmentStatus = "completed"
+	StatusNoShow    AppointmentStatus = "no_show"
+)
+
+// statusTransitions lists the statuses each status may move to; the rest are terminal
+var statusTransitions = map[AppointmentStatus][]AppointmentStatus{
+	StatusScheduled: {StatusConfirmed, StatusCancelled, StatusCompleted, StatusNoShow},
+	StatusConfirmed: {StatusCancelled, StatusCompleted, StatusNoShow},
+}
+
+func (s AppointmentStatus) IsValid() bool {
+	switch s {
+	case StatusScheduled, StatusConfirmed, StatusCancelled, StatusCompleted, StatusNoShow:
+		return true
+	}
+	return false
+}
+
+// CanTransitionTo reports whether an appointment in status s may move to next
+func (s AppointmentStatus) CanTransitionTo(next AppointmentStatus) bool {
+	for _, allowed := range statusTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+type UpdateStatusRequest struct {
+	ID     uuid.UUID         `json:"id" validate:"required"`
+	Status AppointmentStatus `json:"status" validate:"required"`
+	// Reason is recorded when the new status is cancelled
+	Reason string `json:"reason" validate:"max=1000"`
+}
+
+func (req *UpdateStatusRequest) Validate() error {
+	if req.ID == uuid.Nil {
+		return ErrInvalidID
+	}
+	if !req.Status.IsValid() {
+		return ErrInvalidStatus
+	}
+	if len(req.Reason) > MaxCancellationReasonLength {
+		return ErrInvalidCancelReason
+	}
+	return nil
+}
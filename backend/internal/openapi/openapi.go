@@ -0,0 +1,59 @@
+// Package openapi serves the OpenAPI 3 description of the REST gateway, generated
+// from appointment.proto by protoc-gen-openapi and embedded in the binary.
+package openapi
+
+import (
+	_ "embed"
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+)
+
+// spec is regenerated together with pkg/pb whenever appointment.proto changes
+//
+//go:embed openapi.yaml
+var spec []byte
+
+//go:embed docs.html
+var docsPage []byte
+
+// specJSON converts the embedded document to JSON once
+var specJSON = sync.OnceValues(func() ([]byte, error) {
+	var document map[string]any
+	if err := yaml.Unmarshal(spec, &document); err != nil {
+		return nil, err
+	}
+	return json.Marshal(document)
+})
+
+// Register adds the document, as /openapi.json and /openapi.yaml, and a docs
+// page rendering it at /docs to mux
+func Register(mux *http.ServeMux) {
+	mux.HandleFunc("GET /openapi.json", serveJSON)
+	mux.HandleFunc("GET /openapi.yaml", serveYAML)
+	mux.HandleFunc("GET /docs", serveDocs)
+}
+
+func serveJSON(w http.ResponseWriter, r *http.Request) {
+	data, err := specJSON()
+	if err != nil {
+		logrus.WithError(err).Error("Failed to convert OpenAPI document")
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+}
+
+func serveYAML(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/yaml")
+	w.Write(spec)
+}
+
+func serveDocs(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write(docsPage)
+}
@@ -0,0 +1,150 @@
+// Package ratelimit limits how fast and how many streams at once each client may
+// call the API, using a token bucket per client.
+package ratelimit
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/pasDamola/schedule-management-system/internal/config"
+)
+
+// sweepInterval is how often buckets that have filled up again are dropped
+const sweepInterval = time.Minute
+
+type bucketKey struct {
+	client string
+	// method is empty for the bucket shared by methods without their own limit
+	method string
+}
+
+type bucket struct {
+	limit   config.RateLimit
+	tokens  float64
+	updated time.Time
+}
+
+// refill adds the tokens earned since the bucket was last used
+func (b *bucket) refill(now time.Time) {
+	b.tokens = math.Min(float64(b.limit.Burst), b.tokens+now.Sub(b.updated).Seconds()*b.limit.Rate)
+	b.updated = now
+}
+
+// Limiter keeps a bucket per client and method with its own limit, and one
+// shared by all other methods of the client. Clients are identified by the
+// caller, such as a principal or peer address.
+type Limiter struct {
+	limit      config.RateLimit
+	methods    map[string]config.RateLimit
+	maxStreams int
+	// now is the clock the buckets are refilled by
+	now func() time.Time
+
+	mu        sync.Mutex
+	buckets   map[bucketKey]*bucket
+	streams   map[string]int
+	lastSweep time.Time
+}
+
+func New(cfg config.RateLimitConfig) *Limiter {
+	l := &Limiter{
+		limit:      withMinBurst(cfg.Limit),
+		methods:    make(map[string]config.RateLimit, len(cfg.Methods)),
+		maxStreams: cfg.MaxStreams,
+		now:        time.Now,
+		buckets:    make(map[bucketKey]*bucket),
+		streams:    make(map[string]int),
+		lastSweep:  time.Now(),
+	}
+	for method, limit := range cfg.Methods {
+		l.methods[method] = withMinBurst(limit)
+	}
+	return l
+}
+
+// withMinBurst lets a bucket hold at least one token, so calls can get through
+func withMinBurst(limit config.RateLimit) config.RateLimit {
+	if limit.Burst < 1 {
+		limit.Burst = 1
+	}
+	return limit
+}
+
+// Allow takes a token from client's bucket for method. When the bucket is empty
+// the call is refused, along with how long until the next token is added.
+func (l *Limiter) Allow(client, method string) (config.RateLimit, bool, time.Duration) {
+	limit, ok := l.methods[method]
+	if !ok {
+		limit, method = l.limit, ""
+	}
+	if limit.Rate <= 0 {
+		return limit, true, 0
+	}
+
+	now := l.now()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.sweep(now)
+
+	key := bucketKey{client: client, method: method}
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{limit: limit, tokens: float64(limit.Burst), updated: now}
+		l.buckets[key] = b
+	}
+	b.refill(now)
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return limit, true, 0
+	}
+	wait := time.Duration((1 - b.tokens) / limit.Rate * float64(time.Second))
+	return limit, false, wait
+}
+
+// sweep drops the buckets that are full again, since a new bucket starts full
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < sweepInterval {
+		return
+	}
+	l.lastSweep = now
+
+	for key, b := range l.buckets {
+		if b.refill(now); b.tokens >= float64(b.limit.Burst) {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// AcquireStream counts a stream opened by client against its cap. It returns
+// false when client is at the cap, and otherwise a func that releases the stream
+// once it ends.
+func (l *Limiter) AcquireStream(client string) (func(), bool) {
+	if l.maxStreams <= 0 {
+		return func() {}, true
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.streams[client] >= l.maxStreams {
+		return nil, false
+	}
+	l.streams[client]++
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			l.mu.Lock()
+			defer l.mu.Unlock()
+			if l.streams[client]--; l.streams[client] <= 0 {
+				delete(l.streams, client)
+			}
+		})
+	}, true
+}
+
+// MaxStreams is the number of streams a client may hold open at once, 0 for no cap
+func (l *Limiter) MaxStreams() int {
+	return l.maxStreams
+}
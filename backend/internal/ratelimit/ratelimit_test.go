@@ -0,0 +1,204 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pasDamola/schedule-management-system/internal/config"
+)
+
+// clock is a settable time source for the limiter
+type clock struct{ t time.Time }
+
+func (c *clock) now() time.Time          { return c.t }
+func (c *clock) advance(d time.Duration) { c.t = c.t.Add(d) }
+
+func newTestLimiter(cfg config.RateLimitConfig) (*Limiter, *clock) {
+	c := &clock{t: time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)}
+	l := New(cfg)
+	l.now = c.now
+	l.lastSweep = c.t
+	return l, c
+}
+
+func TestAllow(t *testing.T) {
+	type call struct {
+		after  time.Duration
+		client string
+		method string
+		allow  bool
+		wait   time.Duration
+	}
+	tests := []struct {
+		name  string
+		cfg   config.RateLimitConfig
+		calls []call
+	}{
+		{
+			name: "burst then refused",
+			cfg:  config.RateLimitConfig{Limit: config.RateLimit{Rate: 1, Burst: 2}},
+			calls: []call{
+				{client: "a", allow: true},
+				{client: "a", allow: true},
+				{client: "a", allow: false, wait: time.Second},
+			},
+		},
+		{
+			name: "refills over time",
+			cfg:  config.RateLimitConfig{Limit: config.RateLimit{Rate: 2, Burst: 1}},
+			calls: []call{
+				{client: "a", allow: true},
+				{after: 250 * time.Millisecond, client: "a", allow: false, wait: 250 * time.Millisecond},
+				{after: 250 * time.Millisecond, client: "a", allow: true},
+			},
+		},
+		{
+			name: "refill is capped at burst",
+			cfg:  config.RateLimitConfig{Limit: config.RateLimit{Rate: 10, Burst: 2}},
+			calls: []call{
+				{client: "a", allow: true},
+				{after: time.Hour, client: "a", allow: true},
+				{client: "a", allow: true},
+				{client: "a", allow: false, wait: 100 * time.Millisecond},
+			},
+		},
+		{
+			name: "clients have their own buckets",
+			cfg:  config.RateLimitConfig{Limit: config.RateLimit{Rate: 1, Burst: 1}},
+			calls: []call{
+				{client: "a", allow: true},
+				{client: "b", allow: true},
+				{client: "a", allow: false, wait: time.Second},
+			},
+		},
+		{
+			name: "methods with a limit have their own bucket",
+			cfg: config.RateLimitConfig{
+				Limit:   config.RateLimit{Rate: 1, Burst: 1},
+				Methods: map[string]config.RateLimit{"Create": {Rate: 1, Burst: 1}},
+			},
+			calls: []call{
+				{client: "a", method: "Create", allow: true},
+				{client: "a", method: "Create", allow: false, wait: time.Second},
+				{client: "a", method: "Get", allow: true},
+				{client: "a", method: "List", allow: false, wait: time.Second},
+			},
+		},
+		{
+			name: "zero burst still lets one call through",
+			cfg:  config.RateLimitConfig{Limit: config.RateLimit{Rate: 1}},
+			calls: []call{
+				{client: "a", allow: true},
+				{client: "a", allow: false, wait: time.Second},
+			},
+		},
+		{
+			name: "zero rate is unlimited",
+			cfg:  config.RateLimitConfig{Limit: config.RateLimit{Burst: 1}},
+			calls: []call{
+				{client: "a", allow: true},
+				{client: "a", allow: true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, c := newTestLimiter(tt.cfg)
+			for i, call := range tt.calls {
+				c.advance(call.after)
+				_, allow, wait := l.Allow(call.client, call.method)
+				if allow != call.allow || wait != call.wait {
+					t.Errorf("call %d: Allow() = %v, %v; want %v, %v", i, allow, wait, call.allow, call.wait)
+				}
+			}
+		})
+	}
+}
+
+func TestSweep(t *testing.T) {
+	tests := []struct {
+		name  string
+		after time.Duration
+		want  int
+	}{
+		{name: "before the interval", after: sweepInterval - time.Second, want: 3},
+		{name: "drops full buckets", after: sweepInterval, want: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, c := newTestLimiter(config.RateLimitConfig{
+				Limit:   config.RateLimit{Rate: 1, Burst: 1000},
+				Methods: map[string]config.RateLimit{"Slow": {Rate: 0.001, Burst: 1}},
+			})
+			l.Allow("a", "")
+			l.Allow("a", "Slow")
+
+			// The next call sweeps; the slow bucket is still empty and is kept
+			c.advance(tt.after)
+			l.Allow("b", "Slow")
+			if got := len(l.buckets); got != tt.want {
+				t.Errorf("buckets = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAcquireStream(t *testing.T) {
+	tests := []struct {
+		name       string
+		maxStreams int
+		acquire    int
+		want       bool
+	}{
+		{name: "below the cap", maxStreams: 2, acquire: 1, want: true},
+		{name: "at the cap", maxStreams: 2, acquire: 2, want: false},
+		{name: "no cap", maxStreams: 0, acquire: 10, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := New(config.RateLimitConfig{MaxStreams: tt.maxStreams})
+			for i := 0; i < tt.acquire; i++ {
+				if _, ok := l.AcquireStream("a"); !ok {
+					t.Fatalf("stream %d refused", i)
+				}
+			}
+			if _, ok := l.AcquireStream("a"); ok != tt.want {
+				t.Errorf("AcquireStream() = %v, want %v", ok, tt.want)
+			}
+			if _, ok := l.AcquireStream("b"); !ok {
+				t.Error("other client refused")
+			}
+		})
+	}
+}
+
+func TestStreamRelease(t *testing.T) {
+	l := New(config.RateLimitConfig{MaxStreams: 2})
+	release, _ := l.AcquireStream("a")
+	if _, ok := l.AcquireStream("a"); !ok {
+		t.Fatal("second stream refused")
+	}
+	if _, ok := l.AcquireStream("a"); ok {
+		t.Fatal("stream over the cap allowed")
+	}
+
+	// Releasing twice frees a single slot
+	release()
+	release()
+	if _, ok := l.AcquireStream("a"); !ok {
+		t.Fatal("stream refused after release")
+	}
+	if _, ok := l.AcquireStream("a"); ok {
+		t.Error("double release freed a second slot")
+	}
+
+	l = New(config.RateLimitConfig{MaxStreams: 1})
+	release, _ = l.AcquireStream("a")
+	release()
+	if _, ok := l.streams["a"]; ok {
+		t.Error("released client still counted")
+	}
+}
@@ -0,0 +1,160 @@
+package records
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/pasDamola/schedule-management-system/internal/models"
+)
+
+// Row is a line of an imported file mapped to a create request. Line is the
+// 1-based line the row starts on. Err is set when the row cannot be parsed;
+// Request then holds what could be read.
+type Row struct {
+	Line    int
+	Request *models.CreateAppointmentRequest
+	Err     error
+}
+
+// Decode reads every row of r in opts.Format. Only an unreadable file, or a CSV
+// file without a usable header, is returned as an error.
+func Decode(r io.Reader, opts *models.RecordImportOptions) ([]Row, error) {
+	switch opts.Format {
+	case models.RecordFormatCSV:
+		return decodeCSV(r, opts)
+	case models.RecordFormatNDJSON:
+		return decodeNDJSON(r, opts)
+	default:
+		return nil, models.ErrInvalidRecordFormat
+	}
+}
+
+func decodeCSV(r io.Reader, opts *models.RecordImportOptions) ([]Row, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, models.ErrInvalidCSVHeader
+	}
+
+	// Spreadsheet exports often start with a byte order mark
+	header[0] = strings.TrimPrefix(header[0], "\ufeff")
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	if _, ok := columns["title"]; !ok {
+		return nil, models.ErrInvalidCSVHeader
+	}
+
+	var rows []Row
+	for {
+		fields, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if !errors.As(err, &parseErr) {
+				return nil, fmt.Errorf("failed to read CSV data: %v", err)
+			}
+			rows = append(rows, Row{
+				Line:    parseErr.StartLine,
+				Request: &models.CreateAppointmentRequest{},
+				Err:     &models.RecordError{Field: "row", Reason: parseErr.Err.Error()},
+			})
+			continue
+		}
+
+		line, _ := reader.FieldPos(0)
+		get := func(name string) string {
+			if i, ok := columns[name]; ok {
+				return fields[i]
+			}
+			return ""
+		}
+
+		record := &Record{
+			Title:      get("title"),
+			StartTime:  get("start_time"),
+			EndTime:    get("end_time"),
+			StartDate:  get("start_date"),
+			EndDate:    get("end_date"),
+			TimeZone:   get("time_zone"),
+			CalendarID: get("calendar_id"),
+			ICalUID:    get("ical_uid"),
+		}
+
+		if allDay := strings.TrimSpace(get("all_day")); allDay != "" {
+			if record.AllDay, err = strconv.ParseBool(allDay); err != nil {
+				rows = append(rows, Row{
+					Line:    line,
+					Request: &models.CreateAppointmentRequest{Title: strings.TrimSpace(record.Title)},
+					Err:     &models.RecordError{Field: "all_day", Reason: "must be true or false"},
+				})
+				continue
+			}
+		}
+
+		req, err := record.Request(opts)
+		rows = append(rows, Row{Line: line, Request: req, Err: err})
+	}
+
+	return rows, nil
+}
+
+func decodeNDJSON(r io.Reader, opts *models.RecordImportOptions) ([]Row, error) {
+	reader := bufio.NewReader(r)
+
+	var rows []Row
+	for line := 1; ; line++ {
+		data, err := reader.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return nil, fmt.Errorf("failed to read NDJSON data: %v", err)
+		}
+
+		if data = bytes.TrimSpace(data); len(data) > 0 {
+			rows = append(rows, decodeNDJSONLine(data, line, opts))
+		}
+
+		if err == io.EOF {
+			break
+		}
+	}
+
+	return rows, nil
+}
+
+func decodeNDJSONLine(data []byte, line int, opts *models.RecordImportOptions) Row {
+	record := &Record{}
+	if err := json.Unmarshal(data, record); err != nil {
+		row := Row{Line: line, Request: &models.CreateAppointmentRequest{}}
+
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) && typeErr.Field != "" {
+			reason := "must be a string"
+			if typeErr.Type.Kind() == reflect.Bool {
+				reason = "must be true or false"
+			}
+			row.Err = &models.RecordError{Field: typeErr.Field, Reason: reason}
+		} else {
+			row.Err = &models.RecordError{Field: "row", Reason: "must be a single JSON object"}
+		}
+		return row
+	}
+
+	req, err := record.Request(opts)
+	return Row{Line: line, Request: req, Err: err}
+}
@@ -0,0 +1,157 @@
+package records
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pasDamola/schedule-management-system/internal/models"
+)
+
+// wantRow is what a decoded row should hold. errField names the field of the
+// expected *models.RecordError, if any.
+type wantRow struct {
+	line       int
+	title      string
+	start      time.Time
+	allDay     bool
+	dates      [2]string
+	calendarID string
+	timeZone   string
+	errField   string
+}
+
+func TestDecode(t *testing.T) {
+	start := time.Date(2025, 3, 3, 9, 0, 0, 0, time.UTC)
+	opts := func(format models.RecordFormat) *models.RecordImportOptions {
+		return &models.RecordImportOptions{Format: format, CalendarID: "team", TimeZone: "Europe/Berlin"}
+	}
+
+	tests := []struct {
+		name    string
+		opts    *models.RecordImportOptions
+		data    string
+		wantErr error
+		want    []wantRow
+	}{
+		{
+			name: "CSV",
+			opts: opts(models.RecordFormatCSV),
+			data: "title,start_time,end_time,calendar_id,time_zone\n" +
+				"Review,2025-03-03T09:00:00Z,2025-03-03T10:00:00Z,sales,UTC\n" +
+				" Standup ,2025-03-03T09:00:00Z,2025-03-03T10:00:00Z,,\n",
+			want: []wantRow{
+				{line: 2, title: "Review", start: start, calendarID: "sales", timeZone: "UTC"},
+				{line: 3, title: "Standup", start: start, calendarID: "team", timeZone: "Europe/Berlin"},
+			},
+		},
+		{
+			name: "CSV with a byte order mark and columns in any order",
+			opts: opts(models.RecordFormatCSV),
+			data: "\ufeffStart_Time,ID,Title\n2025-03-03T09:00:00Z,ignored,Review\n",
+			want: []wantRow{{line: 2, title: "Review", start: start, calendarID: "team", timeZone: "Europe/Berlin"}},
+		},
+		{
+			name: "CSV all-day row",
+			opts: opts(models.RecordFormatCSV),
+			data: "title,all_day,start_date,end_date\nOffsite,true,2025-03-04,2025-03-05\n",
+			want: []wantRow{{line: 2, title: "Offsite", allDay: true, dates: [2]string{"2025-03-04", "2025-03-05"}, calendarID: "team", timeZone: "Europe/Berlin"}},
+		},
+		{
+			name: "CSV rows that cannot be parsed",
+			opts: opts(models.RecordFormatCSV),
+			data: "title,start_time,all_day\n" +
+				"Review,tomorrow,\n" +
+				"Offsite,,maybe\n" +
+				"\"Unclosed,,\n",
+			want: []wantRow{
+				{line: 2, title: "Review", calendarID: "team", timeZone: "Europe/Berlin", errField: "start_time"},
+				{line: 3, title: "Offsite", errField: "all_day"},
+				{line: 4, errField: "row"},
+			},
+		},
+		{
+			name:    "CSV without a title column",
+			opts:    opts(models.RecordFormatCSV),
+			data:    "name,start_time\nReview,2025-03-03T09:00:00Z\n",
+			wantErr: models.ErrInvalidCSVHeader,
+		},
+		{
+			name: "empty CSV",
+			opts: opts(models.RecordFormatCSV),
+		},
+		{
+			name: "NDJSON",
+			opts: opts(models.RecordFormatNDJSON),
+			data: `{"title":"Review","start_time":"2025-03-03T09:00:00Z","end_time":"2025-03-03T10:00:00Z","time_zone":"UTC"}` + "\n\n" +
+				`{"title":"Offsite","all_day":true,"start_date":"2025-03-04","end_date":"2025-03-05","calendar_id":"sales"}`,
+			want: []wantRow{
+				{line: 1, title: "Review", start: start, calendarID: "team", timeZone: "UTC"},
+				{line: 3, title: "Offsite", allDay: true, dates: [2]string{"2025-03-04", "2025-03-05"}, calendarID: "sales", timeZone: "Europe/Berlin"},
+			},
+		},
+		{
+			name: "NDJSON lines that cannot be parsed",
+			opts: opts(models.RecordFormatNDJSON),
+			data: `{"title":"Review","end_time":"10am"}` + "\n" +
+				`{"title":1}` + "\n" +
+				`{"title":"Offsite","all_day":"yes"}` + "\n" +
+				`["Standup"]` + "\n",
+			want: []wantRow{
+				{line: 1, title: "Review", calendarID: "team", timeZone: "Europe/Berlin", errField: "end_time"},
+				{line: 2, errField: "title"},
+				{line: 3, errField: "all_day"},
+				{line: 4, errField: "row"},
+			},
+		},
+		{
+			name:    "unknown format",
+			opts:    &models.RecordImportOptions{Format: "xlsx"},
+			data:    "title\nReview\n",
+			wantErr: models.ErrInvalidRecordFormat,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := Decode(strings.NewReader(tt.data), tt.opts)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Decode() error = %v, want %v", err, tt.wantErr)
+			}
+			if len(rows) != len(tt.want) {
+				t.Fatalf("got %d rows, want %d", len(rows), len(tt.want))
+			}
+
+			for i, want := range tt.want {
+				row := rows[i]
+				var recordErr *models.RecordError
+				switch {
+				case want.errField == "" && row.Err != nil:
+					t.Errorf("row %d: unexpected error %v", i, row.Err)
+				case want.errField != "" && (!errors.As(row.Err, &recordErr) || recordErr.Field != want.errField):
+					t.Errorf("row %d: error = %v, want an invalid %s", i, row.Err, want.errField)
+				}
+
+				req := row.Request
+				got := wantRow{
+					line:       row.Line,
+					title:      req.Title,
+					start:      req.StartTime,
+					allDay:     req.AllDay,
+					dates:      [2]string{req.StartDate, req.EndDate},
+					calendarID: req.CalendarID,
+					timeZone:   req.TimeZone,
+					errField:   want.errField,
+				}
+				if !got.start.Equal(want.start) {
+					t.Errorf("row %d: start = %v, want %v", i, got.start, want.start)
+				}
+				got.start = want.start
+				if got != want {
+					t.Errorf("row %d = %+v, want %+v", i, got, want)
+				}
+			}
+		})
+	}
+}
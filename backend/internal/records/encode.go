@@ -0,0 +1,56 @@
+package records
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+
+	"github.com/pasDamola/schedule-management-system/internal/models"
+)
+
+// Encode writes appointments to w in format. CSV output starts with a header row.
+func Encode(w io.Writer, format models.RecordFormat, appointments []models.Appointment) error {
+	switch format {
+	case models.RecordFormatCSV:
+		return encodeCSV(w, appointments)
+	case models.RecordFormatNDJSON:
+		return encodeNDJSON(w, appointments)
+	default:
+		return models.ErrInvalidRecordFormat
+	}
+}
+
+func encodeCSV(w io.Writer, appointments []models.Appointment) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(Columns); err != nil {
+		return err
+	}
+
+	for i := range appointments {
+		record := NewRecord(&appointments[i])
+		err := writer.Write([]string{
+			record.ID, record.Title, record.StartTime, record.EndTime,
+			strconv.FormatBool(record.AllDay), record.StartDate, record.EndDate,
+			record.TimeZone, record.CalendarID, record.Status,
+			record.CancellationReason, record.ICalUID,
+			record.CreatedAt, record.UpdatedAt,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func encodeNDJSON(w io.Writer, appointments []models.Appointment) error {
+	encoder := json.NewEncoder(w)
+	for i := range appointments {
+		if err := encoder.Encode(NewRecord(&appointments[i])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+// Package records encodes appointments as CSV rows or newline-delimited JSON
+// objects for spreadsheets and back-office tools, and decodes them for import.
+package records
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pasDamola/schedule-management-system/internal/models"
+)
+
+// Columns are the CSV header and NDJSON keys, in export order. Only title,
+// start_time, end_time, all_day, start_date, end_date, time_zone, calendar_id and
+// ical_uid are read on import; the other columns are ignored.
+var Columns = []string{
+	"id", "title", "start_time", "end_time", "all_day", "start_date", "end_date",
+	"time_zone", "calendar_id", "status", "cancellation_reason", "ical_uid",
+	"created_at", "updated_at",
+}
+
+// Record is one appointment as written to and read from a file. Times are RFC
+// 3339 in the appointment's time zone, dates are YYYY-MM-DD.
+type Record struct {
+	ID                 string `json:"id,omitempty"`
+	Title              string `json:"title"`
+	StartTime          string `json:"start_time,omitempty"`
+	EndTime            string `json:"end_time,omitempty"`
+	AllDay             bool   `json:"all_day"`
+	StartDate          string `json:"start_date,omitempty"`
+	EndDate            string `json:"end_date,omitempty"`
+	TimeZone           string `json:"time_zone,omitempty"`
+	CalendarID         string `json:"calendar_id,omitempty"`
+	Status             string `json:"status,omitempty"`
+	CancellationReason string `json:"cancellation_reason,omitempty"`
+	ICalUID            string `json:"ical_uid,omitempty"`
+	CreatedAt          string `json:"created_at,omitempty"`
+	UpdatedAt          string `json:"updated_at,omitempty"`
+}
+
+// NewRecord maps an appointment to a record
+func NewRecord(appointment *models.Appointment) *Record {
+	loc, err := models.LoadTimeZone(appointment.TimeZone)
+	if err != nil {
+		loc = time.UTC
+	}
+
+	record := &Record{
+		ID:                 appointment.ID.String(),
+		Title:              appointment.Title,
+		StartTime:          appointment.StartTime.In(loc).Format(time.RFC3339),
+		EndTime:            appointment.EndTime.In(loc).Format(time.RFC3339),
+		AllDay:             appointment.AllDay,
+		TimeZone:           appointment.TimeZone,
+		CalendarID:         appointment.CalendarID,
+		Status:             string(appointment.Status),
+		CancellationReason: appointment.CancellationReason,
+		ICalUID:            appointment.ICalUID,
+		CreatedAt:          appointment.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:          appointment.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+	if appointment.AllDay {
+		record.StartDate, record.EndDate = appointment.AllDayDates()
+	}
+	return record
+}
+
+// Request maps a record to a create request. Empty calendar IDs and time zones
+// are taken from opts. Only unparseable fields are reported here, as a
+// *models.RecordError; everything else is left to request validation.
+func (rec *Record) Request(opts *models.RecordImportOptions) (*models.CreateAppointmentRequest, error) {
+	req := &models.CreateAppointmentRequest{
+		Title:      strings.TrimSpace(rec.Title),
+		CalendarID: strings.TrimSpace(rec.CalendarID),
+		TimeZone:   strings.TrimSpace(rec.TimeZone),
+		AllDay:     rec.AllDay,
+		ICalUID:    strings.TrimSpace(rec.ICalUID),
+	}
+	if req.CalendarID == "" {
+		req.CalendarID = opts.CalendarID
+	}
+	if req.TimeZone == "" {
+		req.TimeZone = opts.TimeZone
+	}
+
+	if rec.AllDay {
+		req.StartDate = strings.TrimSpace(rec.StartDate)
+		req.EndDate = strings.TrimSpace(rec.EndDate)
+		return req, nil
+	}
+
+	var err error
+	if req.StartTime, err = parseTime("start_time", rec.StartTime); err != nil {
+		return req, err
+	}
+	if req.EndTime, err = parseTime("end_time", rec.EndTime); err != nil {
+		return req, err
+	}
+	return req, nil
+}
+
+// parseTime reads an RFC 3339 timestamp, leaving empty values as the zero time
+func parseTime(field, value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, &models.RecordError{Field: field, Reason: "must be an RFC 3339 timestamp such as 2025-01-02T15:04:05Z"}
+	}
+	return t, nil
+}
@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/pasDamola/schedule-management-system/internal/database"
+	"github.com/pasDamola/schedule-management-system/internal/models"
+	"github.com/sirupsen/logrus"
+)
+
+type APIKeyRepository interface {
+	Create(ctx context.Context, key *models.APIKey, keyHash string) (*models.APIKey, error)
+	List(ctx context.Context) ([]models.APIKey, error)
+	Revoke(ctx context.Context, id uuid.UUID) error
+	GetByKeyHash(ctx context.Context, keyHash string) (*models.APIKey, error)
+	MarkUsed(ctx context.Context, id uuid.UUID) error
+}
+
+// apiKeyColumns is the column list matching scanAPIKey
+const apiKeyColumns = "id, name, scopes, prefix, created_by, created_at, last_used_at, revoked_at, tenant_id"
+
+type apiKeyRepository struct {
+	db *database.DB
+}
+
+func NewAPIKeyRepository(db *database.DB) APIKeyRepository {
+	return &tracedAPIKeys{next: &apiKeyRepository{db: db}}
+}
+
+func scanAPIKey(row rowScanner, key *models.APIKey) error {
+	return row.Scan(
+		&key.ID, &key.Name, pq.Array(&key.Scopes), &key.Prefix, &key.CreatedBy,
+		&key.CreatedAt, &key.LastUsedAt, &key.RevokedAt, &key.TenantID,
+	)
+}
+
+// Create stores a new key in the caller's tenant
+func (r *apiKeyRepository) Create(ctx context.Context, key *models.APIKey, keyHash string) (*models.APIKey, error) {
+	created := &models.APIKey{}
+	query := `
+		INSERT INTO api_keys (id, tenant_id, name, scopes, prefix, key_hash, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING ` + apiKeyColumns
+
+	err := scanAPIKey(r.db.QueryRowContext(ctx, query,
+		uuid.New(), tenantOf(ctx), key.Name, pq.Array(key.Scopes), key.Prefix, keyHash, key.CreatedBy,
+	), created)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create API key: %v", err)
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"api_key_id": created.ID,
+		"name":       created.Name,
+	}).Info("API key created successfully")
+	return created, nil
+}
+
+// List returns the active keys of the caller's tenant, oldest first
+func (r *apiKeyRepository) List(ctx context.Context) ([]models.APIKey, error) {
+	query := `
+		SELECT ` + apiKeyColumns + `
+		FROM api_keys
+		WHERE tenant_id = $1 AND revoked_at IS NULL
+		ORDER BY created_at ASC`
+
+	rows, err := r.db.QueryContext(ctx, query, tenantOf(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list API keys: %v", err)
+	}
+	defer rows.Close()
+
+	var keys []models.APIKey
+	for rows.Next() {
+		var key models.APIKey
+		if err := scanAPIKey(rows, &key); err != nil {
+			return nil, fmt.Errorf("failed to scan API key: %v", err)
+		}
+		keys = append(keys, key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate API keys: %v", err)
+	}
+
+	return keys, nil
+}
+
+func (r *apiKeyRepository) Revoke(ctx context.Context, id uuid.UUID) error {
+	query := "UPDATE api_keys SET revoked_at = NOW() WHERE id = $1 AND tenant_id = $2 AND revoked_at IS NULL"
+
+	result, err := r.db.ExecContext(ctx, query, id, tenantOf(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to revoke API key: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %v", err)
+	}
+	if rowsAffected == 0 {
+		return models.ErrAPIKeyNotFound
+	}
+
+	logrus.WithField("api_key_id", id).Info("API key revoked successfully")
+	return nil
+}
+
+// GetByKeyHash looks an active key up in every tenant, since the caller's tenant
+// is only known once the key is; the key's TenantID says which one it acts in
+func (r *apiKeyRepository) GetByKeyHash(ctx context.Context, keyHash string) (*models.APIKey, error) {
+	key := &models.APIKey{}
+	query := `
+		SELECT ` + apiKeyColumns + `
+		FROM api_keys
+		WHERE key_hash = $1 AND revoked_at IS NULL`
+
+	if err := scanAPIKey(r.db.QueryRowContext(ctx, query, keyHash), key); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, models.ErrAPIKeyNotFound
+		}
+		return nil, fmt.Errorf("failed to get API key: %v", err)
+	}
+
+	return key, nil
+}
+
+// MarkUsed records that a key was just used
+func (r *apiKeyRepository) MarkUsed(ctx context.Context, id uuid.UUID) error {
+	if _, err := r.db.ExecContext(ctx, "UPDATE api_keys SET last_used_at = NOW() WHERE id = $1", id); err != nil {
+		return fmt.Errorf("failed to record API key use: %v", err)
+	}
+	return nil
+}
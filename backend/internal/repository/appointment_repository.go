@@ -3,22 +3,64 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/pasDamola/schedule-management-system/internal/database"
+	"github.com/pasDamola/schedule-management-system/internal/metrics"
 	"github.com/pasDamola/schedule-management-system/internal/models"
+	"github.com/pasDamola/schedule-management-system/internal/requestinfo"
 	"github.com/sirupsen/logrus"
 )
 
 type AppointmentRepository interface {
 	Create(ctx context.Context, appointment *models.CreateAppointmentRequest) (*models.Appointment, error)
 	GetByID(ctx context.Context, id uuid.UUID) (*models.Appointment, error)
+	GetDeletedByID(ctx context.Context, id uuid.UUID) (*models.Appointment, error)
 	Delete(ctx context.Context, id uuid.UUID) error
 	List(ctx context.Context, req *models.ListAppointmentsRequest) (*models.ListAppointmentsResponse, error)
 	CheckConflict(ctx context.Context, startTime, endTime time.Time, excludeID *uuid.UUID) (bool, error)
+	GetStatistics(ctx context.Context, req *models.StatisticsRequest) (*models.StatisticsResponse, error)
+	ConfirmHold(ctx context.Context, req *models.ConfirmHoldRequest) (*models.Appointment, error)
+	DeleteExpiredHolds(ctx context.Context) ([]models.Appointment, error)
+	UpdateStatus(ctx context.Context, id uuid.UUID, from, to models.AppointmentStatus, reason string) (*models.Appointment, error)
+	Restore(ctx context.Context, id uuid.UUID) (*models.Appointment, error)
+	Purge(ctx context.Context, id uuid.UUID) error
+	CreateBatch(ctx context.Context, reqs []*models.CreateAppointmentRequest) ([]*models.Appointment, error)
+	DeleteBatch(ctx context.Context, ids []uuid.UUID) ([]*models.Appointment, error)
+	Replace(ctx context.Context, id uuid.UUID, req *models.CreateAppointmentRequest) (*models.Appointment, error)
+	CreateEach(ctx context.Context, reqs []*models.CreateAppointmentRequest, dryRun bool) ([]models.BatchItemResult, error)
+	FindByICalUIDs(ctx context.Context, uids []string) (map[string]uuid.UUID, error)
+	PurgeDeletedBefore(ctx context.Context, cutoff time.Time) (int64, error)
+}
+
+// appointmentColumns is the column list matching scanAppointment
+const appointmentColumns = "id, title, start_time, end_time, calendar_id, time_zone, all_day, created_at, updated_at, " +
+	"hold_expires_at, status, COALESCE(cancellation_reason, ''), cancelled_at, deleted_at, COALESCE(ical_uid, ''), owner, created_by, tenant_id"
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanAppointment(row rowScanner, appointment *models.Appointment) error {
+	return row.Scan(
+		&appointment.ID, &appointment.Title, &appointment.StartTime,
+		&appointment.EndTime, &appointment.CalendarID, &appointment.TimeZone,
+		&appointment.AllDay, &appointment.CreatedAt, &appointment.UpdatedAt,
+		&appointment.HoldExpiresAt, &appointment.Status, &appointment.CancellationReason,
+		&appointment.CancelledAt, &appointment.DeletedAt, &appointment.ICalUID,
+		&appointment.Owner, &appointment.CreatedBy, &appointment.TenantID,
+	)
+}
+
+// tenantOf returns the tenant whose data the request in ctx may touch. Every
+// query outside the background sweeps is limited to it.
+func tenantOf(ctx context.Context) string {
+	return requestinfo.FromContext(ctx).Tenant
 }
 
 type appointmentRepository struct {
@@ -26,7 +68,7 @@ type appointmentRepository struct {
 }
 
 func NewAppointmentRepository(db *database.DB) AppointmentRepository {
-	return &appointmentRepository{db: db}
+	return &tracedAppointments{next: &appointmentRepository{db: db}}
 }
 
 func (r *appointmentRepository) Create(ctx context.Context, req *models.CreateAppointmentRequest) (*models.Appointment, error) {
@@ -37,65 +79,146 @@ func (r *appointmentRepository) Create(ctx context.Context, req *models.CreateAp
 	}
 	defer tx.Rollback()
 
-	// Check for conflicts using database function
-	var hasConflict bool
-	err = tx.QueryRowContext(ctx, 
-		"SELECT check_appointment_conflict($1, $2)", 
-		req.StartTime, req.EndTime,
-	).Scan(&hasConflict)
+	appointment, err := r.createInTx(ctx, tx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to check conflicts: %v", err)
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %v", err)
 	}
 
-	if hasConflict {
-		return nil, models.ErrAppointmentConflict
+	logrus.WithField("appointment_id", appointment.ID).Info("Appointment created successfully")
+	return appointment, nil
+}
+
+// createInTx checks for conflicts and inserts an appointment inside tx
+func (r *appointmentRepository) createInTx(ctx context.Context, tx *sql.Tx, req *models.CreateAppointmentRequest) (*models.Appointment, error) {
+	calendarID := req.CalendarID
+	if calendarID == "" {
+		calendarID = models.DefaultCalendarID
+	}
+	timeZone := req.TimeZone
+	if timeZone == "" {
+		timeZone = models.DefaultTimeZone
+	}
+	tenantID := tenantOf(ctx)
+
+	// All-day events only block time when their calendar is configured to
+	blocksTime := true
+	if req.AllDay {
+		var err error
+		if blocksTime, err = allDayBlocksTime(ctx, tx, tenantID, calendarID); err != nil {
+			return nil, err
+		}
+	}
+
+	if blocksTime {
+		if err := findConflicts(ctx, tx, tenantID, req.StartTime, req.EndTime, uuid.NullUUID{}); err != nil {
+			if errors.Is(err, models.ErrAppointmentConflict) {
+				metrics.ConflictsRejected.WithLabelValues("create").Inc()
+			}
+			return nil, err
+		}
 	}
 
 	// Insert new appointment
 	appointment := &models.Appointment{
-		ID:        uuid.New(),
-		Title:     req.Title,
-		StartTime: req.StartTime,
-		EndTime:   req.EndTime,
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
+		ID:         uuid.New(),
+		Title:      req.Title,
+		StartTime:  req.StartTime,
+		EndTime:    req.EndTime,
+		CalendarID: calendarID,
+		TimeZone:   timeZone,
+		AllDay:     req.AllDay,
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+
+		HoldExpiresAt: req.HoldExpiresAt,
+		ICalUID:       req.ICalUID,
+
+		// The caller recorded in the audit log becomes the owner
+		Owner:     requestinfo.FromContext(ctx).Actor,
+		CreatedBy: requestinfo.FromContext(ctx).Actor,
+		TenantID:  tenantID,
 	}
 
 	query := `
-		INSERT INTO appointments (id, title, start_time, end_time, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6)
-		RETURNING id, title, start_time, end_time, created_at, updated_at`
-
-	err = tx.QueryRowContext(ctx, query,
-		appointment.ID, appointment.Title, appointment.StartTime,
-		appointment.EndTime, appointment.CreatedAt, appointment.UpdatedAt,
-	).Scan(
-		&appointment.ID, &appointment.Title, &appointment.StartTime,
-		&appointment.EndTime, &appointment.CreatedAt, &appointment.UpdatedAt,
-	)
+		INSERT INTO appointments (id, title, start_time, end_time, calendar_id, time_zone, all_day, created_at, updated_at, hold_expires_at, ical_uid, owner, created_by, tenant_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, NULLIF($11, ''), $12, $13, $14)
+		RETURNING ` + appointmentColumns
+
+	err := scanAppointment(tx.QueryRowContext(ctx, query,
+		appointment.ID, appointment.Title, appointment.StartTime, appointment.EndTime,
+		appointment.CalendarID, appointment.TimeZone, appointment.AllDay,
+		appointment.CreatedAt, appointment.UpdatedAt, appointment.HoldExpiresAt, appointment.ICalUID,
+		appointment.Owner, appointment.CreatedBy, appointment.TenantID,
+	), appointment)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create appointment: %v", err)
 	}
 
-	if err := tx.Commit(); err != nil {
-		return nil, fmt.Errorf("failed to commit transaction: %v", err)
+	if err := recordHistory(ctx, tx, models.AuditActionCreated, nil, appointment); err != nil {
+		return nil, err
 	}
 
-	logrus.WithField("appointment_id", appointment.ID).Info("Appointment created successfully")
 	return appointment, nil
 }
 
+// allDayBlocksTime reports whether all-day events in the calendar take part in conflict checks
+func allDayBlocksTime(ctx context.Context, tx *sql.Tx, tenantID, calendarID string) (bool, error) {
+	var blocksTime bool
+	err := tx.QueryRowContext(ctx,
+		"SELECT COALESCE((SELECT all_day_blocks_time FROM calendar_settings WHERE tenant_id = $1 AND calendar_id = $2), FALSE)",
+		tenantID, calendarID,
+	).Scan(&blocksTime)
+	if err != nil {
+		return false, fmt.Errorf("failed to get calendar settings: %v", err)
+	}
+	return blocksTime, nil
+}
+
+// maxReportedConflicts bounds how many overlapping appointments a conflict names
+const maxReportedConflicts = 10
+
+// findConflicts returns a ConflictError naming the appointments of the tenant
+// that start to end overlaps, leaving out exclude, or nil when there are none
+func findConflicts(ctx context.Context, tx *sql.Tx, tenantID string, startTime, endTime time.Time, exclude uuid.NullUUID) error {
+	rows, err := tx.QueryContext(ctx,
+		"SELECT id, start_time, end_time FROM find_appointment_conflicts($1, $2, $3, $4) LIMIT $5",
+		tenantID, startTime, endTime, exclude, maxReportedConflicts,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to check conflicts: %v", err)
+	}
+	defer rows.Close()
+
+	var conflicts []models.Conflict
+	for rows.Next() {
+		var conflict models.Conflict
+		if err := rows.Scan(&conflict.ID, &conflict.StartTime, &conflict.EndTime); err != nil {
+			return fmt.Errorf("failed to scan conflict: %v", err)
+		}
+		conflicts = append(conflicts, conflict)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to check conflicts: %v", err)
+	}
+
+	if len(conflicts) > 0 {
+		return &models.ConflictError{Conflicts: conflicts}
+	}
+	return nil
+}
+
 func (r *appointmentRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Appointment, error) {
 	appointment := &models.Appointment{}
 	query := `
-		SELECT id, title, start_time, end_time, created_at, updated_at
+		SELECT ` + appointmentColumns + `
 		FROM appointments
-		WHERE id = $1`
+		WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL`
 
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
-		&appointment.ID, &appointment.Title, &appointment.StartTime,
-		&appointment.EndTime, &appointment.CreatedAt, &appointment.UpdatedAt,
-	)
+	err := scanAppointment(r.db.QueryRowContext(ctx, query, id, tenantOf(ctx)), appointment)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, models.ErrAppointmentNotFound
@@ -106,27 +229,542 @@ func (r *appointmentRepository) GetByID(ctx context.Context, id uuid.UUID) (*mod
 	return appointment, nil
 }
 
+// GetDeletedByID returns an appointment that is in the trash
+func (r *appointmentRepository) GetDeletedByID(ctx context.Context, id uuid.UUID) (*models.Appointment, error) {
+	appointment := &models.Appointment{}
+	query := `
+		SELECT ` + appointmentColumns + `
+		FROM appointments
+		WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NOT NULL`
+
+	err := scanAppointment(r.db.QueryRowContext(ctx, query, id, tenantOf(ctx)), appointment)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, models.ErrAppointmentNotFound
+		}
+		return nil, fmt.Errorf("failed to get appointment: %v", err)
+	}
 
+	return appointment, nil
+}
+
+// Delete moves an appointment to the trash
 func (r *appointmentRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	query := `DELETE FROM appointments WHERE id = $1`
-	result, err := r.db.ExecContext(ctx, query, id)
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := deleteInTx(ctx, tx, id); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	logrus.WithField("appointment_id", id).Info("Appointment moved to trash successfully")
+	return nil
+}
+
+// deleteInTx moves an appointment to the trash inside tx and returns it as it was
+// before the delete
+func deleteInTx(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*models.Appointment, error) {
+	before, err := lockAppointment(ctx, tx, id, false)
+	if err != nil {
+		return nil, err
+	}
+
+	after := &models.Appointment{}
+	query := `
+		UPDATE appointments
+		SET deleted_at = NOW(), updated_at = NOW()
+		WHERE id = $1
+		RETURNING ` + appointmentColumns
+
+	if err := scanAppointment(tx.QueryRowContext(ctx, query, id), after); err != nil {
+		return nil, fmt.Errorf("failed to delete appointment: %v", err)
+	}
+
+	if err := recordHistory(ctx, tx, models.AuditActionDeleted, before, after); err != nil {
+		return nil, err
+	}
+
+	return before, nil
+}
+
+// CreateBatch creates every appointment in one transaction. Items are inserted in
+// order, so conflicts between items of the same batch are caught like any other.
+// On failure nothing is created and the error is a *models.BatchItemError.
+func (r *appointmentRepository) CreateBatch(ctx context.Context, reqs []*models.CreateAppointmentRequest) ([]*models.Appointment, error) {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	appointments := make([]*models.Appointment, 0, len(reqs))
+	for i, req := range reqs {
+		appointment, err := r.createInTx(ctx, tx, req)
+		if err != nil {
+			return nil, &models.BatchItemError{Index: i, Err: err}
+		}
+		appointments = append(appointments, appointment)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	logrus.WithField("count", len(appointments)).Info("Appointment batch created successfully")
+	return appointments, nil
+}
+
+// Replace overwrites the title, times, time zone and all-day flag of a live
+// appointment, checking the new slot for conflicts with every other appointment
+func (r *appointmentRepository) Replace(ctx context.Context, id uuid.UUID, req *models.CreateAppointmentRequest) (*models.Appointment, error) {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	before, err := lockAppointment(ctx, tx, id, false)
+	if err != nil {
+		return nil, err
+	}
+
+	timeZone := req.TimeZone
+	if timeZone == "" {
+		timeZone = models.DefaultTimeZone
+	}
+
+	// Cancelled appointments and non-blocking all-day events never conflict
+	blocksTime := before.Status != models.StatusCancelled
+	if blocksTime && req.AllDay {
+		if blocksTime, err = allDayBlocksTime(ctx, tx, before.TenantID, before.CalendarID); err != nil {
+			return nil, err
+		}
+	}
+
+	if blocksTime {
+		exclude := uuid.NullUUID{UUID: id, Valid: true}
+		if err := findConflicts(ctx, tx, before.TenantID, req.StartTime, req.EndTime, exclude); err != nil {
+			if errors.Is(err, models.ErrAppointmentConflict) {
+				metrics.ConflictsRejected.WithLabelValues("replace").Inc()
+			}
+			return nil, err
+		}
+	}
+
+	appointment := &models.Appointment{}
+	query := `
+		UPDATE appointments
+		SET title = $2, start_time = $3, end_time = $4, time_zone = $5, all_day = $6, updated_at = NOW()
+		WHERE id = $1
+		RETURNING ` + appointmentColumns
+
+	err = scanAppointment(tx.QueryRowContext(ctx, query,
+		id, req.Title, req.StartTime, req.EndTime, timeZone, req.AllDay,
+	), appointment)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update appointment: %v", err)
+	}
+
+	if err := recordHistory(ctx, tx, models.AuditActionUpdated, before, appointment); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	logrus.WithField("appointment_id", id).Info("Appointment updated successfully")
+	return appointment, nil
+}
+
+// CreateEach creates every appointment in one transaction, each under its own
+// savepoint so a failing item is rolled back without undoing the others. Later
+// items see earlier ones when checking for conflicts. With dryRun the whole
+// transaction is rolled back, so the results show what would have happened.
+func (r *appointmentRepository) CreateEach(ctx context.Context, reqs []*models.CreateAppointmentRequest, dryRun bool) ([]models.BatchItemResult, error) {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	results := make([]models.BatchItemResult, len(reqs))
+	for i, req := range reqs {
+		results[i].Index = i
+
+		if _, err := tx.ExecContext(ctx, "SAVEPOINT create_each"); err != nil {
+			return nil, fmt.Errorf("failed to create savepoint: %v", err)
+		}
+
+		appointment, err := r.createInTx(ctx, tx, req)
+		if err != nil {
+			results[i].Err = err
+			if _, err := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT create_each"); err != nil {
+				return nil, fmt.Errorf("failed to roll back to savepoint: %v", err)
+			}
+			continue
+		}
+
+		if _, err := tx.ExecContext(ctx, "RELEASE SAVEPOINT create_each"); err != nil {
+			return nil, fmt.Errorf("failed to release savepoint: %v", err)
+		}
+		results[i].ID = appointment.ID
+		results[i].Appointment = appointment
+	}
+
+	if dryRun {
+		return results, nil
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	return results, nil
+}
+
+// FindByICalUIDs maps each of uids that belongs to a live appointment, either as
+// its imported UID or as its ID, to that appointment's ID
+func (r *appointmentRepository) FindByICalUIDs(ctx context.Context, uids []string) (map[string]uuid.UUID, error) {
+	found := make(map[string]uuid.UUID)
+	if len(uids) == 0 {
+		return found, nil
+	}
+
+	query := `
+		SELECT COALESCE(ical_uid, id::text), id
+		FROM appointments
+		WHERE (ical_uid = ANY($1) OR id::text = ANY($1)) AND tenant_id = $2 AND deleted_at IS NULL`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(uids), tenantOf(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find appointments by iCalendar UID: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var uid string
+		var id uuid.UUID
+		if err := rows.Scan(&uid, &id); err != nil {
+			return nil, fmt.Errorf("failed to scan iCalendar UID: %v", err)
+		}
+		found[uid] = id
+		// Imported appointments also answer to their own ID
+		found[id.String()] = id
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate iCalendar UIDs: %v", err)
+	}
+
+	return found, nil
+}
+
+// DeleteBatch moves every appointment to the trash in one transaction and returns
+// them as they were before the delete. On failure nothing is deleted and the error
+// is a *models.BatchItemError.
+func (r *appointmentRepository) DeleteBatch(ctx context.Context, ids []uuid.UUID) ([]*models.Appointment, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
-		return fmt.Errorf("failed to delete appointment: %v", err)
+		return nil, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	appointments := make([]*models.Appointment, 0, len(ids))
+	for i, id := range ids {
+		appointment, err := deleteInTx(ctx, tx, id)
+		if err != nil {
+			return nil, &models.BatchItemError{Index: i, Err: err}
+		}
+		appointments = append(appointments, appointment)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	logrus.WithField("count", len(appointments)).Info("Appointment batch moved to trash successfully")
+	return appointments, nil
+}
+
+// lockAppointment loads a live or trashed appointment of the caller's tenant and
+// locks its row until tx ends
+func lockAppointment(ctx context.Context, tx *sql.Tx, id uuid.UUID, deleted bool) (*models.Appointment, error) {
+	trashCondition := "deleted_at IS NULL"
+	if deleted {
+		trashCondition = "deleted_at IS NOT NULL"
+	}
+
+	appointment := &models.Appointment{}
+	query := `
+		SELECT ` + appointmentColumns + `
+		FROM appointments
+		WHERE id = $1 AND tenant_id = $2 AND ` + trashCondition + `
+		FOR UPDATE`
+
+	if err := scanAppointment(tx.QueryRowContext(ctx, query, id, tenantOf(ctx)), appointment); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, models.ErrAppointmentNotFound
+		}
+		return nil, fmt.Errorf("failed to get appointment: %v", err)
+	}
+
+	return appointment, nil
+}
+
+// Restore takes an appointment out of the trash, provided its slot is still free
+func (r *appointmentRepository) Restore(ctx context.Context, id uuid.UUID) (*models.Appointment, error) {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	deleted, err := lockAppointment(ctx, tx, id, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Cancelled appointments and non-blocking all-day events never conflict
+	blocksTime := deleted.Status != models.StatusCancelled
+	if blocksTime && deleted.AllDay {
+		if blocksTime, err = allDayBlocksTime(ctx, tx, deleted.TenantID, deleted.CalendarID); err != nil {
+			return nil, err
+		}
+	}
+
+	if blocksTime {
+		exclude := uuid.NullUUID{UUID: id, Valid: true}
+		if err := findConflicts(ctx, tx, deleted.TenantID, deleted.StartTime, deleted.EndTime, exclude); err != nil {
+			if errors.Is(err, models.ErrAppointmentConflict) {
+				metrics.ConflictsRejected.WithLabelValues("restore").Inc()
+			}
+			return nil, err
+		}
+	}
+
+	appointment := &models.Appointment{}
+	query := `
+		UPDATE appointments
+		SET deleted_at = NULL, updated_at = NOW()
+		WHERE id = $1
+		RETURNING ` + appointmentColumns
+
+	if err := scanAppointment(tx.QueryRowContext(ctx, query, id), appointment); err != nil {
+		return nil, fmt.Errorf("failed to restore appointment: %v", err)
+	}
+
+	if err := recordHistory(ctx, tx, models.AuditActionRestored, deleted, appointment); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %v", err)
 	}
 
-	rowsAffected, err := result.RowsAffected()
+	logrus.WithField("appointment_id", id).Info("Appointment restored successfully")
+	return appointment, nil
+}
+
+// Purge permanently removes an appointment that is in the trash
+func (r *appointmentRepository) Purge(ctx context.Context, id uuid.UUID) error {
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %v", err)
+		return fmt.Errorf("failed to begin transaction: %v", err)
 	}
+	defer tx.Rollback()
 
-	if rowsAffected == 0 {
-		return models.ErrAppointmentNotFound
+	if _, err := deleteAppointments(ctx, tx, "id = $1 AND tenant_id = $2 AND deleted_at IS NOT NULL", id, tenantOf(ctx)); err != nil {
+		return err
 	}
 
-	logrus.WithField("appointment_id", id).Info("Appointment deleted successfully")
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	logrus.WithField("appointment_id", id).Info("Appointment purged successfully")
 	return nil
 }
 
+// PurgeDeletedBefore permanently removes appointments trashed before cutoff, in
+// every tenant
+func (r *appointmentRepository) PurgeDeletedBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	purged, err := deleteAppointments(ctx, tx, "deleted_at IS NOT NULL AND deleted_at < $1", cutoff)
+	if err != nil && err != models.ErrAppointmentNotFound {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	return int64(len(purged)), nil
+}
+
+// deleteAppointments hard-deletes the appointments matching condition and records
+// a purge for each. It returns ErrAppointmentNotFound when nothing matched.
+func deleteAppointments(ctx context.Context, tx *sql.Tx, condition string, args ...interface{}) ([]models.Appointment, error) {
+	query := `
+		DELETE FROM appointments
+		WHERE ` + condition + `
+		RETURNING ` + appointmentColumns
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete appointments: %v", err)
+	}
+	defer rows.Close()
+
+	var deleted []models.Appointment
+	for rows.Next() {
+		var appointment models.Appointment
+		if err := scanAppointment(rows, &appointment); err != nil {
+			return nil, fmt.Errorf("failed to scan deleted appointment: %v", err)
+		}
+		deleted = append(deleted, appointment)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate deleted appointments: %v", err)
+	}
+	rows.Close()
+
+	if len(deleted) == 0 {
+		return nil, models.ErrAppointmentNotFound
+	}
+
+	for i := range deleted {
+		if err := recordHistory(ctx, tx, models.AuditActionPurged, &deleted[i], nil); err != nil {
+			return nil, err
+		}
+	}
+
+	return deleted, nil
+}
+
+// ConfirmHold turns an unexpired hold into a regular appointment
+func (r *appointmentRepository) ConfirmHold(ctx context.Context, req *models.ConfirmHoldRequest) (*models.Appointment, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	hold, err := lockAppointment(ctx, tx, req.ID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if hold.HoldExpiresAt == nil {
+		return nil, models.ErrNotAHold
+	}
+	if !hold.HoldExpiresAt.After(time.Now()) {
+		return nil, models.ErrHoldExpired
+	}
+
+	appointment := &models.Appointment{}
+	query := `
+		UPDATE appointments
+		SET hold_expires_at = NULL, title = COALESCE(NULLIF($2, ''), title), updated_at = NOW()
+		WHERE id = $1
+		RETURNING ` + appointmentColumns
+
+	if err := scanAppointment(tx.QueryRowContext(ctx, query, req.ID, req.Title), appointment); err != nil {
+		return nil, fmt.Errorf("failed to confirm hold: %v", err)
+	}
+
+	if err := recordHistory(ctx, tx, models.AuditActionUpdated, hold, appointment); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	logrus.WithField("appointment_id", appointment.ID).Info("Hold confirmed successfully")
+	return appointment, nil
+}
+
+// DeleteExpiredHolds removes holds past their expiry in every tenant and returns them
+func (r *appointmentRepository) DeleteExpiredHolds(ctx context.Context) ([]models.Appointment, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	holds, err := deleteAppointments(ctx, tx, "hold_expires_at IS NOT NULL AND hold_expires_at <= NOW()")
+	if err != nil {
+		if err == models.ErrAppointmentNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	return holds, nil
+}
+
+// UpdateStatus moves an appointment from one status to another. It fails with
+// ErrInvalidStatusTransition when the appointment is no longer in status from.
+func (r *appointmentRepository) UpdateStatus(ctx context.Context, id uuid.UUID, from, to models.AppointmentStatus, reason string) (*models.Appointment, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	before, err := lockAppointment(ctx, tx, id, false)
+	if err != nil {
+		return nil, err
+	}
+	if before.Status != from {
+		return nil, models.ErrInvalidStatusTransition
+	}
+
+	appointment := &models.Appointment{}
+	query := `
+		UPDATE appointments
+		SET status = $2,
+			cancellation_reason = CASE WHEN $2 = 'cancelled' THEN NULLIF($3, '') ELSE cancellation_reason END,
+			cancelled_at = CASE WHEN $2 = 'cancelled' THEN NOW() ELSE cancelled_at END,
+			updated_at = NOW()
+		WHERE id = $1
+		RETURNING ` + appointmentColumns
+
+	if err := scanAppointment(tx.QueryRowContext(ctx, query, id, string(to), reason), appointment); err != nil {
+		return nil, fmt.Errorf("failed to update appointment status: %v", err)
+	}
+
+	if err := recordHistory(ctx, tx, models.AuditActionUpdated, before, appointment); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"appointment_id": id,
+		"status":         to,
+	}).Info("Appointment status updated successfully")
+	return appointment, nil
+}
+
 func (r *appointmentRepository) List(ctx context.Context, req *models.ListAppointmentsRequest) (*models.ListAppointmentsResponse, error) {
 	// Set defaults
 	if req.Page <= 0 {
@@ -136,10 +774,19 @@ func (r *appointmentRepository) List(ctx context.Context, req *models.ListAppoin
 		req.Limit = 20
 	}
 
-	// Build query with filters
-	var whereConditions []string
-	var args []interface{}
-	argIndex := 1
+	// Build query with filters; expired holds are hidden until the reaper removes them
+	whereConditions := []string{"tenant_id = $1", "(hold_expires_at IS NULL OR hold_expires_at > NOW())"}
+	args := []interface{}{tenantOf(ctx)}
+	argIndex := 2
+
+	// The trash is listed separately from live appointments
+	orderBy := "start_time ASC"
+	if req.Deleted {
+		whereConditions = append(whereConditions, "deleted_at IS NOT NULL")
+		orderBy = "deleted_at DESC"
+	} else {
+		whereConditions = append(whereConditions, "deleted_at IS NULL")
+	}
 
 	if req.Search != "" {
 		whereConditions = append(whereConditions, fmt.Sprintf("to_tsvector('english', title) @@ plainto_tsquery('english', $%d)", argIndex))
@@ -159,6 +806,28 @@ func (r *appointmentRepository) List(ctx context.Context, req *models.ListAppoin
 		argIndex++
 	}
 
+	if req.CalendarID != "" {
+		whereConditions = append(whereConditions, fmt.Sprintf("calendar_id = $%d", argIndex))
+		args = append(args, req.CalendarID)
+		argIndex++
+	}
+
+	if req.Visible != nil {
+		whereConditions = append(whereConditions, fmt.Sprintf("(owner = $%d OR calendar_id = ANY($%d))", argIndex, argIndex+1))
+		args = append(args, req.Visible.Owner, pq.Array(req.Visible.CalendarIDs))
+		argIndex += 2
+	}
+
+	if len(req.Statuses) > 0 {
+		statuses := make([]string, len(req.Statuses))
+		for i, status := range req.Statuses {
+			statuses[i] = string(status)
+		}
+		whereConditions = append(whereConditions, fmt.Sprintf("status = ANY($%d)", argIndex))
+		args = append(args, pq.Array(statuses))
+		argIndex++
+	}
+
 	whereClause := ""
 	if len(whereConditions) > 0 {
 		whereClause = "WHERE " + strings.Join(whereConditions, " AND ")
@@ -175,11 +844,11 @@ func (r *appointmentRepository) List(ctx context.Context, req *models.ListAppoin
 	// Get appointments with pagination
 	offset := (req.Page - 1) * req.Limit
 	query := fmt.Sprintf(`
-		SELECT id, title, start_time, end_time, created_at, updated_at
+		SELECT %s
 		FROM appointments %s
-		ORDER BY start_time ASC
+		ORDER BY %s
 		LIMIT $%d OFFSET $%d`,
-		whereClause, argIndex, argIndex+1)
+		appointmentColumns, whereClause, orderBy, argIndex, argIndex+1)
 
 	args = append(args, req.Limit, offset)
 
@@ -192,15 +861,14 @@ func (r *appointmentRepository) List(ctx context.Context, req *models.ListAppoin
 	var appointments []models.Appointment
 	for rows.Next() {
 		var appointment models.Appointment
-		err := rows.Scan(
-			&appointment.ID, &appointment.Title, &appointment.StartTime,
-			&appointment.EndTime, &appointment.CreatedAt, &appointment.UpdatedAt,
-		)
-		if err != nil {
+		if err := scanAppointment(rows, &appointment); err != nil {
 			return nil, fmt.Errorf("failed to scan appointment: %v", err)
 		}
 		appointments = append(appointments, appointment)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate appointments: %v", err)
+	}
 
 	return &models.ListAppointmentsResponse{
 		Appointments: appointments,
@@ -216,13 +884,13 @@ func (r *appointmentRepository) CheckConflict(ctx context.Context, startTime, en
 
 	if excludeID != nil {
 		err = r.db.QueryRowContext(ctx,
-			"SELECT check_appointment_conflict($1, $2, $3)",
-			startTime, endTime, *excludeID,
+			"SELECT check_appointment_conflict($1, $2, $3, $4)",
+			tenantOf(ctx), startTime, endTime, *excludeID,
 		).Scan(&hasConflict)
 	} else {
 		err = r.db.QueryRowContext(ctx,
-			"SELECT check_appointment_conflict($1, $2)",
-			startTime, endTime,
+			"SELECT check_appointment_conflict($1, $2, $3)",
+			tenantOf(ctx), startTime, endTime,
 		).Scan(&hasConflict)
 	}
 
@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pasDamola/schedule-management-system/internal/models"
+)
+
+// peakHoursLimit is the number of busiest start hours reported
+const peakHoursLimit = 3
+
+// statisticsAggregates computes the per-row figures shared by every statistics query.
+// $1 and $2 are the window bounds; booked time is clipped to the window.
+// Cancelled appointments are only counted as cancellations.
+const statisticsAggregates = `
+	COUNT(*) FILTER (WHERE status != 'cancelled'),
+	COALESCE(SUM(EXTRACT(EPOCH FROM (LEAST(end_time, $2) - GREATEST(start_time, $1)))) FILTER (WHERE status != 'cancelled'), 0) / 3600.0,
+	COALESCE(AVG(EXTRACT(EPOCH FROM (end_time - start_time))) FILTER (WHERE status != 'cancelled'), 0) / 60.0,
+	COUNT(*) FILTER (WHERE status = 'cancelled')`
+
+// statisticsWindow selects the tenant's ($3) confirmed bookings overlapping the window;
+// tentative holds and appointments in the trash are left out
+const statisticsWindow = "tenant_id = $3 AND start_time < $2 AND end_time > $1 AND hold_expires_at IS NULL AND deleted_at IS NULL"
+
+// statisticsLocalStart is the wall clock start time in the reporting time zone ($4)
+const statisticsLocalStart = "(start_time AT TIME ZONE $4::text)"
+
+// statisticsGroupKeys maps a grouping to the SQL expression used as its bucket key
+var statisticsGroupKeys = map[models.StatisticsGroupBy]string{
+	models.GroupByDay:      "to_char(date_trunc('day', " + statisticsLocalStart + "), 'YYYY-MM-DD')",
+	models.GroupByWeek:     "to_char(date_trunc('week', " + statisticsLocalStart + "), 'YYYY-MM-DD')",
+	models.GroupByCalendar: "calendar_id",
+}
+
+func (r *appointmentRepository) GetStatistics(ctx context.Context, req *models.StatisticsRequest) (*models.StatisticsResponse, error) {
+	response := &models.StatisticsResponse{
+		Summary: models.AppointmentStatistics{
+			PeriodStart: req.StartDate,
+			PeriodEnd:   req.EndDate,
+		},
+	}
+
+	// Summary over the whole window
+	summaryQuery := fmt.Sprintf("SELECT %s FROM appointments WHERE %s", statisticsAggregates, statisticsWindow)
+	tenantID := tenantOf(ctx)
+	err := r.db.QueryRowContext(ctx, summaryQuery, req.StartDate, req.EndDate, tenantID).Scan(
+		&response.Summary.AppointmentCount, &response.Summary.BookedHours, &response.Summary.AverageDurationMinutes,
+		&response.Summary.CancellationCount,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get statistics summary: %v", err)
+	}
+
+	// Per-group breakdown
+	if keyExpr, ok := statisticsGroupKeys[req.GroupBy]; ok {
+		groupQuery := fmt.Sprintf(`
+			SELECT %s AS bucket, %s
+			FROM appointments
+			WHERE %s
+			GROUP BY bucket
+			ORDER BY bucket ASC`,
+			keyExpr, statisticsAggregates, statisticsWindow)
+
+		groupArgs := []interface{}{req.StartDate, req.EndDate, tenantID}
+		if req.GroupBy != models.GroupByCalendar {
+			groupArgs = append(groupArgs, req.TimeZone)
+		}
+
+		rows, err := r.db.QueryContext(ctx, groupQuery, groupArgs...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get grouped statistics: %v", err)
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var group models.AppointmentStatistics
+			err := rows.Scan(
+				&group.Key, &group.AppointmentCount, &group.BookedHours, &group.AverageDurationMinutes,
+				&group.CancellationCount,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("failed to scan grouped statistics: %v", err)
+			}
+			response.Groups = append(response.Groups, group)
+		}
+		if err := rows.Err(); err != nil {
+			return nil, fmt.Errorf("failed to iterate grouped statistics: %v", err)
+		}
+	}
+
+	// Busiest start hours
+	peakQuery := fmt.Sprintf(`
+		SELECT EXTRACT(HOUR FROM %s)::int AS hour, COUNT(*)
+		FROM appointments
+		WHERE %s AND status != 'cancelled'
+		GROUP BY hour
+		ORDER BY COUNT(*) DESC, hour ASC
+		LIMIT %d`,
+		statisticsLocalStart, statisticsWindow, peakHoursLimit)
+
+	rows, err := r.db.QueryContext(ctx, peakQuery, req.StartDate, req.EndDate, tenantID, req.TimeZone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get peak hours: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var peak models.HourCount
+		if err := rows.Scan(&peak.Hour, &peak.AppointmentCount); err != nil {
+			return nil, fmt.Errorf("failed to scan peak hours: %v", err)
+		}
+		response.PeakHours = append(response.PeakHours, peak)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate peak hours: %v", err)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,211 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/pasDamola/schedule-management-system/internal/database"
+	"github.com/pasDamola/schedule-management-system/internal/models"
+	"github.com/pasDamola/schedule-management-system/internal/requestinfo"
+)
+
+type AuditRepository interface {
+	GetHistory(ctx context.Context, appointmentID uuid.UUID) ([]models.AuditEvent, error)
+	List(ctx context.Context, req *models.ListAuditEventsRequest) (*models.ListAuditEventsResponse, error)
+}
+
+// auditColumns is the column list matching scanAuditEvent
+const auditColumns = "id, appointment_id, action, actor, request_id, occurred_at, before, after"
+
+type auditRepository struct {
+	db *database.DB
+}
+
+func NewAuditRepository(db *database.DB) AuditRepository {
+	return &tracedAudit{next: &auditRepository{db: db}}
+}
+
+// recordHistory writes an audit event inside tx so it commits or rolls back with the change.
+// The actor and request ID are taken from ctx, and the tenant from the appointment.
+func recordHistory(ctx context.Context, tx *sql.Tx, action models.AuditAction, before, after *models.Appointment) error {
+	appointmentID, tenantID := uuid.Nil, ""
+	switch {
+	case after != nil:
+		appointmentID, tenantID = after.ID, after.TenantID
+	case before != nil:
+		appointmentID, tenantID = before.ID, before.TenantID
+	}
+
+	beforeJSON, err := snapshotJSON(before)
+	if err != nil {
+		return err
+	}
+	afterJSON, err := snapshotJSON(after)
+	if err != nil {
+		return err
+	}
+
+	info := requestinfo.FromContext(ctx)
+	query := `
+		INSERT INTO appointment_history (appointment_id, action, actor, request_id, before, after, tenant_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+	_, err = tx.ExecContext(ctx, query,
+		appointmentID, string(action), info.Actor, info.RequestID, beforeJSON, afterJSON, tenantID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record appointment history: %v", err)
+	}
+
+	return nil
+}
+
+func snapshotJSON(appointment *models.Appointment) (interface{}, error) {
+	if appointment == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(appointment)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode appointment snapshot: %v", err)
+	}
+	return string(data), nil
+}
+
+func scanAuditEvent(row rowScanner, event *models.AuditEvent) error {
+	var before, after []byte
+	err := row.Scan(
+		&event.ID, &event.AppointmentID, &event.Action, &event.Actor,
+		&event.RequestID, &event.OccurredAt, &before, &after,
+	)
+	if err != nil {
+		return err
+	}
+
+	if before != nil {
+		event.Before = &models.Appointment{}
+		if err := json.Unmarshal(before, event.Before); err != nil {
+			return fmt.Errorf("failed to decode appointment snapshot: %v", err)
+		}
+	}
+	if after != nil {
+		event.After = &models.Appointment{}
+		if err := json.Unmarshal(after, event.After); err != nil {
+			return fmt.Errorf("failed to decode appointment snapshot: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// GetHistory returns every recorded change to an appointment, oldest first
+func (r *auditRepository) GetHistory(ctx context.Context, appointmentID uuid.UUID) ([]models.AuditEvent, error) {
+	query := `
+		SELECT ` + auditColumns + `
+		FROM appointment_history
+		WHERE appointment_id = $1 AND tenant_id = $2
+		ORDER BY occurred_at ASC, id ASC`
+
+	rows, err := r.db.QueryContext(ctx, query, appointmentID, tenantOf(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get appointment history: %v", err)
+	}
+	defer rows.Close()
+
+	var events []models.AuditEvent
+	for rows.Next() {
+		var event models.AuditEvent
+		if err := scanAuditEvent(rows, &event); err != nil {
+			return nil, fmt.Errorf("failed to scan audit event: %v", err)
+		}
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate audit events: %v", err)
+	}
+
+	return events, nil
+}
+
+// List returns audit events matching the filters, newest first
+func (r *auditRepository) List(ctx context.Context, req *models.ListAuditEventsRequest) (*models.ListAuditEventsResponse, error) {
+	whereConditions := []string{"tenant_id = $1"}
+	args := []interface{}{tenantOf(ctx)}
+	argIndex := 2
+
+	if req.AppointmentID != uuid.Nil {
+		whereConditions = append(whereConditions, fmt.Sprintf("appointment_id = $%d", argIndex))
+		args = append(args, req.AppointmentID)
+		argIndex++
+	}
+
+	if req.Actor != "" {
+		whereConditions = append(whereConditions, fmt.Sprintf("actor = $%d", argIndex))
+		args = append(args, req.Actor)
+		argIndex++
+	}
+
+	if req.Action != "" {
+		whereConditions = append(whereConditions, fmt.Sprintf("action = $%d", argIndex))
+		args = append(args, string(req.Action))
+		argIndex++
+	}
+
+	if !req.StartTime.IsZero() {
+		whereConditions = append(whereConditions, fmt.Sprintf("occurred_at >= $%d", argIndex))
+		args = append(args, req.StartTime)
+		argIndex++
+	}
+
+	if !req.EndTime.IsZero() {
+		whereConditions = append(whereConditions, fmt.Sprintf("occurred_at < $%d", argIndex))
+		args = append(args, req.EndTime)
+		argIndex++
+	}
+
+	whereClause := "WHERE " + strings.Join(whereConditions, " AND ")
+
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM appointment_history %s", whereClause)
+	var total int
+	if err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, fmt.Errorf("failed to get total count: %v", err)
+	}
+
+	offset := (req.Page - 1) * req.Limit
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM appointment_history %s
+		ORDER BY occurred_at DESC, id DESC
+		LIMIT $%d OFFSET $%d`,
+		auditColumns, whereClause, argIndex, argIndex+1)
+
+	args = append(args, req.Limit, offset)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list audit events: %v", err)
+	}
+	defer rows.Close()
+
+	var events []models.AuditEvent
+	for rows.Next() {
+		var event models.AuditEvent
+		if err := scanAuditEvent(rows, &event); err != nil {
+			return nil, fmt.Errorf("failed to scan audit event: %v", err)
+		}
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate audit events: %v", err)
+	}
+
+	return &models.ListAuditEventsResponse{
+		Events: events,
+		Total:  total,
+		Page:   req.Page,
+		Limit:  req.Limit,
+	}, nil
+}
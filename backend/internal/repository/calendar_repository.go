@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/pasDamola/schedule-management-system/internal/database"
+	"github.com/pasDamola/schedule-management-system/internal/models"
+	"github.com/sirupsen/logrus"
+)
+
+type CalendarRepository interface {
+	GetSettings(ctx context.Context, calendarID string) (*models.CalendarSettings, error)
+	UpdateSettings(ctx context.Context, req *models.UpdateCalendarSettingsRequest) (*models.CalendarSettings, error)
+	ListCalendarIDs(ctx context.Context) ([]string, error)
+}
+
+type calendarRepository struct {
+	db *database.DB
+}
+
+func NewCalendarRepository(db *database.DB) CalendarRepository {
+	return &tracedCalendars{next: &calendarRepository{db: db}}
+}
+
+// GetSettings returns the stored settings, or the defaults when the calendar has none
+func (r *calendarRepository) GetSettings(ctx context.Context, calendarID string) (*models.CalendarSettings, error) {
+	settings := &models.CalendarSettings{CalendarID: calendarID}
+	query := `
+		SELECT calendar_id, all_day_blocks_time, updated_at
+		FROM calendar_settings
+		WHERE tenant_id = $1 AND calendar_id = $2`
+
+	err := r.db.QueryRowContext(ctx, query, tenantOf(ctx), calendarID).Scan(
+		&settings.CalendarID, &settings.AllDayBlocksTime, &settings.UpdatedAt,
+	)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, fmt.Errorf("failed to get calendar settings: %v", err)
+	}
+
+	return settings, nil
+}
+
+func (r *calendarRepository) UpdateSettings(ctx context.Context, req *models.UpdateCalendarSettingsRequest) (*models.CalendarSettings, error) {
+	settings := &models.CalendarSettings{}
+	query := `
+		INSERT INTO calendar_settings (tenant_id, calendar_id, all_day_blocks_time, updated_at)
+		VALUES ($1, $2, $3, NOW())
+		ON CONFLICT (tenant_id, calendar_id) DO UPDATE
+		SET all_day_blocks_time = EXCLUDED.all_day_blocks_time, updated_at = NOW()
+		RETURNING calendar_id, all_day_blocks_time, updated_at`
+
+	err := r.db.QueryRowContext(ctx, query, tenantOf(ctx), req.CalendarID, req.AllDayBlocksTime).Scan(
+		&settings.CalendarID, &settings.AllDayBlocksTime, &settings.UpdatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update calendar settings: %v", err)
+	}
+
+	logrus.WithField("calendar_id", settings.CalendarID).Info("Calendar settings updated successfully")
+	return settings, nil
+}
+
+// ListCalendarIDs returns every calendar that has appointments or settings,
+// always including the default calendar
+func (r *calendarRepository) ListCalendarIDs(ctx context.Context) ([]string, error) {
+	query := `
+		SELECT calendar_id FROM appointments WHERE tenant_id = $1 AND deleted_at IS NULL
+		UNION
+		SELECT calendar_id FROM calendar_settings WHERE tenant_id = $1
+		UNION
+		SELECT $2::text
+		ORDER BY calendar_id`
+
+	rows, err := r.db.QueryContext(ctx, query, tenantOf(ctx), models.DefaultCalendarID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list calendars: %v", err)
+	}
+	defer rows.Close()
+
+	var calendarIDs []string
+	for rows.Next() {
+		var calendarID string
+		if err := rows.Scan(&calendarID); err != nil {
+			return nil, fmt.Errorf("failed to scan calendar: %v", err)
+		}
+		calendarIDs = append(calendarIDs, calendarID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate calendars: %v", err)
+	}
+
+	return calendarIDs, nil
+}
@@ -0,0 +1,180 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pasDamola/schedule-management-system/internal/database"
+	"github.com/pasDamola/schedule-management-system/internal/models"
+	"github.com/sirupsen/logrus"
+)
+
+type FeedRepository interface {
+	Create(ctx context.Context, calendarID, tokenHash string) (*models.FeedToken, error)
+	Rotate(ctx context.Context, id uuid.UUID, tokenHash string) (*models.FeedToken, error)
+	Revoke(ctx context.Context, id uuid.UUID) error
+	List(ctx context.Context, calendarID string) ([]models.FeedToken, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*models.FeedToken, error)
+	GetByTokenHash(ctx context.Context, tokenHash string) (*models.FeedToken, error)
+	GetVersion(ctx context.Context, calendarID string, since time.Time) (time.Time, int, error)
+}
+
+// feedTokenColumns is the column list matching scanFeedToken
+const feedTokenColumns = "id, calendar_id, created_at, rotated_at, revoked_at, tenant_id"
+
+type feedRepository struct {
+	db *database.DB
+}
+
+func NewFeedRepository(db *database.DB) FeedRepository {
+	return &tracedFeeds{next: &feedRepository{db: db}}
+}
+
+func scanFeedToken(row rowScanner, token *models.FeedToken) error {
+	return row.Scan(&token.ID, &token.CalendarID, &token.CreatedAt, &token.RotatedAt, &token.RevokedAt, &token.TenantID)
+}
+
+func (r *feedRepository) Create(ctx context.Context, calendarID, tokenHash string) (*models.FeedToken, error) {
+	token := &models.FeedToken{}
+	query := `
+		INSERT INTO feed_tokens (id, calendar_id, token_hash, tenant_id)
+		VALUES ($1, $2, $3, $4)
+		RETURNING ` + feedTokenColumns
+
+	if err := scanFeedToken(r.db.QueryRowContext(ctx, query, uuid.New(), calendarID, tokenHash, tenantOf(ctx)), token); err != nil {
+		return nil, fmt.Errorf("failed to create feed token: %v", err)
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"feed_id":     token.ID,
+		"calendar_id": token.CalendarID,
+	}).Info("Feed token created successfully")
+	return token, nil
+}
+
+// Rotate replaces the secret of an active feed token, invalidating the old URL
+func (r *feedRepository) Rotate(ctx context.Context, id uuid.UUID, tokenHash string) (*models.FeedToken, error) {
+	token := &models.FeedToken{}
+	query := `
+		UPDATE feed_tokens
+		SET token_hash = $2, rotated_at = NOW()
+		WHERE id = $1 AND tenant_id = $3 AND revoked_at IS NULL
+		RETURNING ` + feedTokenColumns
+
+	if err := scanFeedToken(r.db.QueryRowContext(ctx, query, id, tokenHash, tenantOf(ctx)), token); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, models.ErrFeedNotFound
+		}
+		return nil, fmt.Errorf("failed to rotate feed token: %v", err)
+	}
+
+	logrus.WithField("feed_id", id).Info("Feed token rotated successfully")
+	return token, nil
+}
+
+func (r *feedRepository) Revoke(ctx context.Context, id uuid.UUID) error {
+	query := "UPDATE feed_tokens SET revoked_at = NOW() WHERE id = $1 AND tenant_id = $2 AND revoked_at IS NULL"
+
+	result, err := r.db.ExecContext(ctx, query, id, tenantOf(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to revoke feed token: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %v", err)
+	}
+	if rowsAffected == 0 {
+		return models.ErrFeedNotFound
+	}
+
+	logrus.WithField("feed_id", id).Info("Feed token revoked successfully")
+	return nil
+}
+
+// List returns the active feed tokens of a calendar, oldest first
+func (r *feedRepository) List(ctx context.Context, calendarID string) ([]models.FeedToken, error) {
+	query := `
+		SELECT ` + feedTokenColumns + `
+		FROM feed_tokens
+		WHERE tenant_id = $1 AND calendar_id = $2 AND revoked_at IS NULL
+		ORDER BY created_at ASC`
+
+	rows, err := r.db.QueryContext(ctx, query, tenantOf(ctx), calendarID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list feed tokens: %v", err)
+	}
+	defer rows.Close()
+
+	var tokens []models.FeedToken
+	for rows.Next() {
+		var token models.FeedToken
+		if err := scanFeedToken(rows, &token); err != nil {
+			return nil, fmt.Errorf("failed to scan feed token: %v", err)
+		}
+		tokens = append(tokens, token)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate feed tokens: %v", err)
+	}
+
+	return tokens, nil
+}
+
+func (r *feedRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.FeedToken, error) {
+	token := &models.FeedToken{}
+	query := `
+		SELECT ` + feedTokenColumns + `
+		FROM feed_tokens
+		WHERE id = $1 AND tenant_id = $2`
+
+	if err := scanFeedToken(r.db.QueryRowContext(ctx, query, id, tenantOf(ctx)), token); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, models.ErrFeedNotFound
+		}
+		return nil, fmt.Errorf("failed to get feed token: %v", err)
+	}
+
+	return token, nil
+}
+
+// GetByTokenHash looks the token up in every tenant, since feed requests carry
+// nothing but the token; the token's TenantID says whose calendar it publishes
+func (r *feedRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*models.FeedToken, error) {
+	token := &models.FeedToken{}
+	query := `
+		SELECT ` + feedTokenColumns + `
+		FROM feed_tokens
+		WHERE token_hash = $1 AND revoked_at IS NULL`
+
+	if err := scanFeedToken(r.db.QueryRowContext(ctx, query, tokenHash), token); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, models.ErrFeedNotFound
+		}
+		return nil, fmt.Errorf("failed to get feed token: %v", err)
+	}
+
+	return token, nil
+}
+
+// GetVersion returns the latest change to the appointments a feed covers and how
+// many of them are visible. Trashed rows count towards the latest change so that
+// deletions show up; the count catches holds that expire or are reaped.
+func (r *feedRepository) GetVersion(ctx context.Context, calendarID string, since time.Time) (time.Time, int, error) {
+	var latest sql.NullTime
+	var count int
+	query := `
+		SELECT MAX(updated_at),
+			COUNT(*) FILTER (WHERE deleted_at IS NULL AND (hold_expires_at IS NULL OR hold_expires_at > NOW()))
+		FROM appointments
+		WHERE tenant_id = $1 AND calendar_id = $2 AND start_time >= $3`
+
+	if err := r.db.QueryRowContext(ctx, query, tenantOf(ctx), calendarID, since).Scan(&latest, &count); err != nil {
+		return time.Time{}, 0, fmt.Errorf("failed to get feed version: %v", err)
+	}
+
+	return latest.Time, count, nil
+}
@@ -0,0 +1,309 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pasDamola/schedule-management-system/internal/models"
+	"github.com/pasDamola/schedule-management-system/internal/tracing"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+)
+
+const tracerScope = "github.com/pasDamola/schedule-management-system/internal/repository"
+
+// traceQuery runs fn in a span named after the repository method, recording the
+// table, the statement name and, on success, the row count given by rows
+func traceQuery[T any](ctx context.Context, repo, table, statement string, rows func(T) int, fn func(context.Context) (T, error)) (T, error) {
+	ctx, span := tracing.Start(ctx, tracerScope, repo+"."+statement,
+		semconv.DBSystemNamePostgreSQL,
+		semconv.DBCollectionName(table),
+		semconv.DBOperationName(statement),
+	)
+	result, err := fn(ctx)
+	if err == nil && rows != nil {
+		span.SetAttributes(semconv.DBResponseReturnedRows(rows(result)))
+	}
+	tracing.End(span, err)
+	return result, err
+}
+
+// traceExec is traceQuery for statements that affect a single row and return nothing
+func traceExec(ctx context.Context, repo, table, statement string, fn func(context.Context) error) error {
+	_, err := traceQuery(ctx, repo, table, statement, one, func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, fn(ctx)
+	})
+	return err
+}
+
+func one[T any](T) int { return 1 }
+
+func countRows[T any](items []T) int { return len(items) }
+
+// tracedAppointments records a span for every AppointmentRepository call
+type tracedAppointments struct {
+	next AppointmentRepository
+}
+
+const appointmentRepo, appointmentTable = "AppointmentRepository", "appointments"
+
+func (t *tracedAppointments) Create(ctx context.Context, req *models.CreateAppointmentRequest) (*models.Appointment, error) {
+	return traceQuery(ctx, appointmentRepo, appointmentTable, "Create", one, func(ctx context.Context) (*models.Appointment, error) {
+		return t.next.Create(ctx, req)
+	})
+}
+
+func (t *tracedAppointments) GetByID(ctx context.Context, id uuid.UUID) (*models.Appointment, error) {
+	return traceQuery(ctx, appointmentRepo, appointmentTable, "GetByID", one, func(ctx context.Context) (*models.Appointment, error) {
+		return t.next.GetByID(ctx, id)
+	})
+}
+
+func (t *tracedAppointments) GetDeletedByID(ctx context.Context, id uuid.UUID) (*models.Appointment, error) {
+	return traceQuery(ctx, appointmentRepo, appointmentTable, "GetDeletedByID", one, func(ctx context.Context) (*models.Appointment, error) {
+		return t.next.GetDeletedByID(ctx, id)
+	})
+}
+
+func (t *tracedAppointments) Delete(ctx context.Context, id uuid.UUID) error {
+	return traceExec(ctx, appointmentRepo, appointmentTable, "Delete", func(ctx context.Context) error {
+		return t.next.Delete(ctx, id)
+	})
+}
+
+func (t *tracedAppointments) List(ctx context.Context, req *models.ListAppointmentsRequest) (*models.ListAppointmentsResponse, error) {
+	return traceQuery(ctx, appointmentRepo, appointmentTable, "List", func(resp *models.ListAppointmentsResponse) int {
+		return len(resp.Appointments)
+	}, func(ctx context.Context) (*models.ListAppointmentsResponse, error) {
+		return t.next.List(ctx, req)
+	})
+}
+
+func (t *tracedAppointments) CheckConflict(ctx context.Context, startTime, endTime time.Time, excludeID *uuid.UUID) (bool, error) {
+	return traceQuery(ctx, appointmentRepo, appointmentTable, "CheckConflict", one, func(ctx context.Context) (bool, error) {
+		return t.next.CheckConflict(ctx, startTime, endTime, excludeID)
+	})
+}
+
+func (t *tracedAppointments) GetStatistics(ctx context.Context, req *models.StatisticsRequest) (*models.StatisticsResponse, error) {
+	return traceQuery(ctx, appointmentRepo, appointmentTable, "GetStatistics", func(resp *models.StatisticsResponse) int {
+		return 1 + len(resp.Groups) + len(resp.PeakHours)
+	}, func(ctx context.Context) (*models.StatisticsResponse, error) {
+		return t.next.GetStatistics(ctx, req)
+	})
+}
+
+func (t *tracedAppointments) ConfirmHold(ctx context.Context, req *models.ConfirmHoldRequest) (*models.Appointment, error) {
+	return traceQuery(ctx, appointmentRepo, appointmentTable, "ConfirmHold", one, func(ctx context.Context) (*models.Appointment, error) {
+		return t.next.ConfirmHold(ctx, req)
+	})
+}
+
+func (t *tracedAppointments) DeleteExpiredHolds(ctx context.Context) ([]models.Appointment, error) {
+	return traceQuery(ctx, appointmentRepo, appointmentTable, "DeleteExpiredHolds", countRows[models.Appointment], func(ctx context.Context) ([]models.Appointment, error) {
+		return t.next.DeleteExpiredHolds(ctx)
+	})
+}
+
+func (t *tracedAppointments) UpdateStatus(ctx context.Context, id uuid.UUID, from, to models.AppointmentStatus, reason string) (*models.Appointment, error) {
+	return traceQuery(ctx, appointmentRepo, appointmentTable, "UpdateStatus", one, func(ctx context.Context) (*models.Appointment, error) {
+		return t.next.UpdateStatus(ctx, id, from, to, reason)
+	})
+}
+
+func (t *tracedAppointments) Restore(ctx context.Context, id uuid.UUID) (*models.Appointment, error) {
+	return traceQuery(ctx, appointmentRepo, appointmentTable, "Restore", one, func(ctx context.Context) (*models.Appointment, error) {
+		return t.next.Restore(ctx, id)
+	})
+}
+
+func (t *tracedAppointments) Purge(ctx context.Context, id uuid.UUID) error {
+	return traceExec(ctx, appointmentRepo, appointmentTable, "Purge", func(ctx context.Context) error {
+		return t.next.Purge(ctx, id)
+	})
+}
+
+func (t *tracedAppointments) CreateBatch(ctx context.Context, reqs []*models.CreateAppointmentRequest) ([]*models.Appointment, error) {
+	return traceQuery(ctx, appointmentRepo, appointmentTable, "CreateBatch", countRows[*models.Appointment], func(ctx context.Context) ([]*models.Appointment, error) {
+		return t.next.CreateBatch(ctx, reqs)
+	})
+}
+
+func (t *tracedAppointments) DeleteBatch(ctx context.Context, ids []uuid.UUID) ([]*models.Appointment, error) {
+	return traceQuery(ctx, appointmentRepo, appointmentTable, "DeleteBatch", countRows[*models.Appointment], func(ctx context.Context) ([]*models.Appointment, error) {
+		return t.next.DeleteBatch(ctx, ids)
+	})
+}
+
+func (t *tracedAppointments) Replace(ctx context.Context, id uuid.UUID, req *models.CreateAppointmentRequest) (*models.Appointment, error) {
+	return traceQuery(ctx, appointmentRepo, appointmentTable, "Replace", one, func(ctx context.Context) (*models.Appointment, error) {
+		return t.next.Replace(ctx, id, req)
+	})
+}
+
+func (t *tracedAppointments) CreateEach(ctx context.Context, reqs []*models.CreateAppointmentRequest, dryRun bool) ([]models.BatchItemResult, error) {
+	// Only the items that were inserted count as rows
+	return traceQuery(ctx, appointmentRepo, appointmentTable, "CreateEach", func(results []models.BatchItemResult) int {
+		created := 0
+		for _, result := range results {
+			if result.Err == nil {
+				created++
+			}
+		}
+		return created
+	}, func(ctx context.Context) ([]models.BatchItemResult, error) {
+		return t.next.CreateEach(ctx, reqs, dryRun)
+	})
+}
+
+func (t *tracedAppointments) FindByICalUIDs(ctx context.Context, uids []string) (map[string]uuid.UUID, error) {
+	return traceQuery(ctx, appointmentRepo, appointmentTable, "FindByICalUIDs", func(ids map[string]uuid.UUID) int {
+		return len(ids)
+	}, func(ctx context.Context) (map[string]uuid.UUID, error) {
+		return t.next.FindByICalUIDs(ctx, uids)
+	})
+}
+
+func (t *tracedAppointments) PurgeDeletedBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	return traceQuery(ctx, appointmentRepo, appointmentTable, "PurgeDeletedBefore", func(purged int64) int {
+		return int(purged)
+	}, func(ctx context.Context) (int64, error) {
+		return t.next.PurgeDeletedBefore(ctx, cutoff)
+	})
+}
+
+// tracedCalendars records a span for every CalendarRepository call
+type tracedCalendars struct {
+	next CalendarRepository
+}
+
+const calendarRepo, calendarTable = "CalendarRepository", "calendar_settings"
+
+func (t *tracedCalendars) GetSettings(ctx context.Context, calendarID string) (*models.CalendarSettings, error) {
+	return traceQuery(ctx, calendarRepo, calendarTable, "GetSettings", one, func(ctx context.Context) (*models.CalendarSettings, error) {
+		return t.next.GetSettings(ctx, calendarID)
+	})
+}
+
+func (t *tracedCalendars) UpdateSettings(ctx context.Context, req *models.UpdateCalendarSettingsRequest) (*models.CalendarSettings, error) {
+	return traceQuery(ctx, calendarRepo, calendarTable, "UpdateSettings", one, func(ctx context.Context) (*models.CalendarSettings, error) {
+		return t.next.UpdateSettings(ctx, req)
+	})
+}
+
+func (t *tracedCalendars) ListCalendarIDs(ctx context.Context) ([]string, error) {
+	return traceQuery(ctx, calendarRepo, calendarTable, "ListCalendarIDs", countRows[string], func(ctx context.Context) ([]string, error) {
+		return t.next.ListCalendarIDs(ctx)
+	})
+}
+
+// tracedAudit records a span for every AuditRepository call
+type tracedAudit struct {
+	next AuditRepository
+}
+
+const auditRepo, auditTable = "AuditRepository", "appointment_history"
+
+func (t *tracedAudit) GetHistory(ctx context.Context, appointmentID uuid.UUID) ([]models.AuditEvent, error) {
+	return traceQuery(ctx, auditRepo, auditTable, "GetHistory", countRows[models.AuditEvent], func(ctx context.Context) ([]models.AuditEvent, error) {
+		return t.next.GetHistory(ctx, appointmentID)
+	})
+}
+
+func (t *tracedAudit) List(ctx context.Context, req *models.ListAuditEventsRequest) (*models.ListAuditEventsResponse, error) {
+	return traceQuery(ctx, auditRepo, auditTable, "List", func(resp *models.ListAuditEventsResponse) int {
+		return len(resp.Events)
+	}, func(ctx context.Context) (*models.ListAuditEventsResponse, error) {
+		return t.next.List(ctx, req)
+	})
+}
+
+// tracedFeeds records a span for every FeedRepository call
+type tracedFeeds struct {
+	next FeedRepository
+}
+
+const feedRepo, feedTable = "FeedRepository", "feed_tokens"
+
+func (t *tracedFeeds) Create(ctx context.Context, calendarID, tokenHash string) (*models.FeedToken, error) {
+	return traceQuery(ctx, feedRepo, feedTable, "Create", one, func(ctx context.Context) (*models.FeedToken, error) {
+		return t.next.Create(ctx, calendarID, tokenHash)
+	})
+}
+
+func (t *tracedFeeds) Rotate(ctx context.Context, id uuid.UUID, tokenHash string) (*models.FeedToken, error) {
+	return traceQuery(ctx, feedRepo, feedTable, "Rotate", one, func(ctx context.Context) (*models.FeedToken, error) {
+		return t.next.Rotate(ctx, id, tokenHash)
+	})
+}
+
+func (t *tracedFeeds) Revoke(ctx context.Context, id uuid.UUID) error {
+	return traceExec(ctx, feedRepo, feedTable, "Revoke", func(ctx context.Context) error {
+		return t.next.Revoke(ctx, id)
+	})
+}
+
+func (t *tracedFeeds) List(ctx context.Context, calendarID string) ([]models.FeedToken, error) {
+	return traceQuery(ctx, feedRepo, feedTable, "List", countRows[models.FeedToken], func(ctx context.Context) ([]models.FeedToken, error) {
+		return t.next.List(ctx, calendarID)
+	})
+}
+
+func (t *tracedFeeds) GetByID(ctx context.Context, id uuid.UUID) (*models.FeedToken, error) {
+	return traceQuery(ctx, feedRepo, feedTable, "GetByID", one, func(ctx context.Context) (*models.FeedToken, error) {
+		return t.next.GetByID(ctx, id)
+	})
+}
+
+func (t *tracedFeeds) GetByTokenHash(ctx context.Context, tokenHash string) (*models.FeedToken, error) {
+	return traceQuery(ctx, feedRepo, feedTable, "GetByTokenHash", one, func(ctx context.Context) (*models.FeedToken, error) {
+		return t.next.GetByTokenHash(ctx, tokenHash)
+	})
+}
+
+func (t *tracedFeeds) GetVersion(ctx context.Context, calendarID string, since time.Time) (time.Time, int, error) {
+	var version int
+	latest, err := traceQuery(ctx, feedRepo, appointmentTable, "GetVersion", one, func(ctx context.Context) (time.Time, error) {
+		latest, v, err := t.next.GetVersion(ctx, calendarID, since)
+		version = v
+		return latest, err
+	})
+	return latest, version, err
+}
+
+// tracedAPIKeys records a span for every APIKeyRepository call
+type tracedAPIKeys struct {
+	next APIKeyRepository
+}
+
+const apiKeyRepo, apiKeyTable = "APIKeyRepository", "api_keys"
+
+func (t *tracedAPIKeys) Create(ctx context.Context, key *models.APIKey, keyHash string) (*models.APIKey, error) {
+	return traceQuery(ctx, apiKeyRepo, apiKeyTable, "Create", one, func(ctx context.Context) (*models.APIKey, error) {
+		return t.next.Create(ctx, key, keyHash)
+	})
+}
+
+func (t *tracedAPIKeys) List(ctx context.Context) ([]models.APIKey, error) {
+	return traceQuery(ctx, apiKeyRepo, apiKeyTable, "List", countRows[models.APIKey], func(ctx context.Context) ([]models.APIKey, error) {
+		return t.next.List(ctx)
+	})
+}
+
+func (t *tracedAPIKeys) Revoke(ctx context.Context, id uuid.UUID) error {
+	return traceExec(ctx, apiKeyRepo, apiKeyTable, "Revoke", func(ctx context.Context) error {
+		return t.next.Revoke(ctx, id)
+	})
+}
+
+func (t *tracedAPIKeys) GetByKeyHash(ctx context.Context, keyHash string) (*models.APIKey, error) {
+	return traceQuery(ctx, apiKeyRepo, apiKeyTable, "GetByKeyHash", one, func(ctx context.Context) (*models.APIKey, error) {
+		return t.next.GetByKeyHash(ctx, keyHash)
+	})
+}
+
+func (t *tracedAPIKeys) MarkUsed(ctx context.Context, id uuid.UUID) error {
+	return traceExec(ctx, apiKeyRepo, apiKeyTable, "MarkUsed", func(ctx context.Context) error {
+		return t.next.MarkUsed(ctx, id)
+	})
+}
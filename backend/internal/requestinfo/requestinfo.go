@@ -0,0 +1,57 @@
+package requestinfo
+
+import "context"
+
+// Actors recorded when no caller identity is available
+const (
+	AnonymousActor = "anonymous"
+	SystemActor    = "system"
+)
+
+// DefaultTenant holds the data of callers that name no tenant, including
+// everything stored before tenants were introduced
+const DefaultTenant = "default"
+
+// MaxTenantLength is the longest tenant ID that can be stored
+const MaxTenantLength = 100
+
+// Info identifies who made a request, which tenant's data it works on and how
+// it can be traced
+type Info struct {
+	Actor     string
+	Tenant    string
+	RequestID string
+}
+
+type contextKey struct{}
+
+// NewContext returns a copy of ctx carrying info
+func NewContext(ctx context.Context, info Info) context.Context {
+	return context.WithValue(ctx, contextKey{}, info)
+}
+
+// FromContext returns the request info stored in ctx, defaulting the actor to
+// AnonymousActor and the tenant to DefaultTenant
+func FromContext(ctx context.Context) Info {
+	info, _ := ctx.Value(contextKey{}).(Info)
+	if info.Actor == "" {
+		info.Actor = AnonymousActor
+	}
+	if info.Tenant == "" {
+		info.Tenant = DefaultTenant
+	}
+	return info
+}
+
+// WithSystemActor marks ctx as belonging to a background job
+func WithSystemActor(ctx context.Context) context.Context {
+	return NewContext(ctx, Info{Actor: SystemActor})
+}
+
+// WithTenant returns a copy of ctx working on tenant's data, keeping the rest
+// of its request info
+func WithTenant(ctx context.Context, tenant string) context.Context {
+	info, _ := ctx.Value(contextKey{}).(Info)
+	info.Tenant = tenant
+	return NewContext(ctx, info)
+}
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pasDamola/schedule-management-system/internal/auth"
+	"github.com/pasDamola/schedule-management-system/internal/models"
+	"github.com/pasDamola/schedule-management-system/internal/requestinfo"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// apiKeyBytes is the amount of randomness in an API key
+	apiKeyBytes = 32
+	// apiKeyPrefix marks API keys so they are recognisable, such as in secret scanners
+	apiKeyPrefix = "smk_"
+	// apiKeyPrefixLength is how much of a key is kept to tell keys apart
+	apiKeyPrefixLength = 12
+	// apiKeyUseInterval limits how often a key's last use is written back
+	apiKeyUseInterval = time.Minute
+)
+
+// scopeRoles are the roles a scope grants on top of limiting the methods a key
+// may call
+var scopeRoles = map[string]string{
+	models.ScopeAppointmentsRead:  RoleViewer,
+	models.ScopeAppointmentsWrite: RoleEditor,
+}
+
+// CreateAPIKey issues a key in the caller's tenant. Only authenticated admins may
+// manage keys, even while authentication is off.
+func (s *appointmentService) CreateAPIKey(ctx context.Context, req *models.CreateAPIKeyRequest) (*models.APIKey, error) {
+	if err := req.Validate(); err != nil {
+		logrus.WithError(err).Error("Invalid create API key request")
+		return nil, err
+	}
+	if err := authorize(accessFromContext(ctx).isAdmin()); err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, apiKeyBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, fmt.Errorf("failed to generate API key: %v", err)
+	}
+	secret := apiKeyPrefix + base64.RawURLEncoding.EncodeToString(buf)
+
+	key, err := s.apiKeys.Create(ctx, &models.APIKey{
+		Name:      req.Name,
+		Scopes:    req.Scopes,
+		Prefix:    secret[:apiKeyPrefixLength],
+		CreatedBy: requestinfo.FromContext(ctx).Actor,
+	}, hashSecret(secret))
+	if err != nil {
+		logrus.WithError(err).Error("Failed to create API key")
+		return nil, err
+	}
+
+	key.Key = secret
+	return key, nil
+}
+
+func (s *appointmentService) ListAPIKeys(ctx context.Context) ([]models.APIKey, error) {
+	if err := authorize(accessFromContext(ctx).isAdmin()); err != nil {
+		return nil, err
+	}
+
+	keys, err := s.apiKeys.List(ctx)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to list API keys")
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+func (s *appointmentService) RevokeAPIKey(ctx context.Context, id uuid.UUID) error {
+	if id == uuid.Nil {
+		return models.ErrInvalidID
+	}
+	if err := authorize(accessFromContext(ctx).isAdmin()); err != nil {
+		return err
+	}
+
+	if err := s.apiKeys.Revoke(ctx, id); err != nil {
+		logrus.WithError(err).WithField("api_key_id", id).Error("Failed to revoke API key")
+		return err
+	}
+
+	return nil
+}
+
+// AuthenticateAPIKey returns the principal a key acts as, in the key's tenant and
+// limited to its scopes. Unknown and revoked keys are ErrInvalidAPIKey.
+func (s *appointmentService) AuthenticateAPIKey(ctx context.Context, secret string) (*auth.Principal, error) {
+	key, err := s.apiKeys.GetByKeyHash(ctx, hashSecret(secret))
+	if err != nil {
+		if err == models.ErrAPIKeyNotFound {
+			return nil, models.ErrInvalidAPIKey
+		}
+		return nil, err
+	}
+
+	// A failed write only leaves last_used_at stale, so the call goes ahead
+	if key.LastUsedAt == nil || time.Since(*key.LastUsedAt) >= apiKeyUseInterval {
+		if err := s.apiKeys.MarkUsed(ctx, key.ID); err != nil {
+			logrus.WithError(err).WithField("api_key_id", key.ID).Warn("Failed to record API key use")
+		}
+	}
+
+	principal := &auth.Principal{
+		Subject: "api-key:" + key.ID.String(),
+		Tenant:  key.TenantID,
+		Scopes:  key.Scopes,
+	}
+	for _, scope := range key.Scopes {
+		if role, ok := scopeRoles[scope]; ok {
+			principal.Roles = append(principal.Roles, role)
+		}
+	}
+	return principal, nil
+}
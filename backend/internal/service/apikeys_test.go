@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/pasDamola/schedule-management-system/internal/models"
+	"github.com/pasDamola/schedule-management-system/internal/repository"
+)
+
+// fakeAPIKeys stores issued keys in memory
+type fakeAPIKeys struct {
+	repository.APIKeyRepository
+	keys []models.APIKey
+}
+
+func (r *fakeAPIKeys) Create(ctx context.Context, key *models.APIKey, keyHash string) (*models.APIKey, error) {
+	key.ID = uuid.New()
+	r.keys = append(r.keys, *key)
+	return key, nil
+}
+
+func (r *fakeAPIKeys) List(ctx context.Context) ([]models.APIKey, error) {
+	return r.keys, nil
+}
+
+func (r *fakeAPIKeys) Revoke(ctx context.Context, id uuid.UUID) error {
+	return nil
+}
+
+func TestManageAPIKeys(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want error
+	}{
+		{name: "admin", ctx: withRoles("admin")},
+		{name: "no principal", ctx: context.Background(), want: models.ErrPermissionDenied},
+		{name: "editor", ctx: withRoles("editor"), want: models.ErrPermissionDenied},
+		{name: "scoped admin", ctx: withRoles("admin:cal-a"), want: models.ErrPermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestService(newFakeRepository())
+			s.apiKeys = &fakeAPIKeys{}
+
+			key, err := s.CreateAPIKey(tt.ctx, &models.CreateAPIKeyRequest{Name: "hr-sync", Scopes: []string{models.ScopeAppointmentsRead}})
+			if !errors.Is(err, tt.want) {
+				t.Errorf("CreateAPIKey() error = %v, want %v", err, tt.want)
+			}
+			if err == nil && !strings.HasPrefix(key.Key, apiKeyPrefix) {
+				t.Errorf("key = %q, want prefix %q", key.Key, apiKeyPrefix)
+			}
+			if _, err := s.ListAPIKeys(tt.ctx); !errors.Is(err, tt.want) {
+				t.Errorf("ListAPIKeys() error = %v, want %v", err, tt.want)
+			}
+			if err := s.RevokeAPIKey(tt.ctx, uuid.New()); !errors.Is(err, tt.want) {
+				t.Errorf("RevokeAPIKey() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
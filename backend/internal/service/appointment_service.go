@@ -2,12 +2,17 @@ package service
 
 import (
 	"context"
+	"io"
 	"sync"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/pasDamola/schedule-management-system/internal/auth"
+	"github.com/pasDamola/schedule-management-system/internal/config"
+	"github.com/pasDamola/schedule-management-system/internal/metrics"
 	"github.com/pasDamola/schedule-management-system/internal/models"
 	"github.com/pasDamola/schedule-management-system/internal/repository"
+	"github.com/pasDamola/schedule-management-system/internal/requestinfo"
 	"github.com/sirupsen/logrus"
 )
 
@@ -15,8 +20,40 @@ type AppointmentService interface {
 	CreateAppointment(ctx context.Context, req *models.CreateAppointmentRequest) (*models.Appointment, error)
 	GetAppointment(ctx context.Context, id uuid.UUID) (*models.Appointment, error)
 	DeleteAppointment(ctx context.Context, id uuid.UUID) error
+	ReplaceAppointment(ctx context.Context, id uuid.UUID, req *models.CreateAppointmentRequest) (*models.Appointment, error)
+	GetAppointmentByICalUID(ctx context.Context, calendarID, uid string) (*models.Appointment, error)
+	BatchCreateAppointments(ctx context.Context, reqs []*models.CreateAppointmentRequest, mode models.BatchMode) ([]models.BatchItemResult, error)
+	BatchDeleteAppointments(ctx context.Context, ids []uuid.UUID, mode models.BatchMode) ([]models.BatchItemResult, error)
 	ListAppointments(ctx context.Context, req *models.ListAppointmentsRequest) (*models.ListAppointmentsResponse, error)
-	SubscribeToUpdates() chan AppointmentEvent
+	ImportICS(ctx context.Context, data io.Reader, opts *models.ImportOptions) (*models.ImportReport, error)
+	ImportRecords(ctx context.Context, data io.Reader, opts *models.RecordImportOptions) (*models.RecordImportReport, error)
+	ExportAppointments(ctx context.Context, req *models.ListAppointmentsRequest) ([]models.Appointment, error)
+	ListCalendarAppointments(ctx context.Context, calendarID string, since time.Time) ([]models.Appointment, error)
+	CreateFeedToken(ctx context.Context, calendarID string) (*models.FeedToken, error)
+	RotateFeedToken(ctx context.Context, id uuid.UUID) (*models.FeedToken, error)
+	RevokeFeedToken(ctx context.Context, id uuid.UUID) error
+	ListFeedTokens(ctx context.Context, calendarID string) ([]models.FeedToken, error)
+	GetFeed(ctx context.Context, token string) (*models.Feed, error)
+	GetStatistics(ctx context.Context, req *models.StatisticsRequest) (*models.StatisticsResponse, error)
+	GetCalendarSettings(ctx context.Context, calendarID string) (*models.CalendarSettings, error)
+	UpdateCalendarSettings(ctx context.Context, req *models.UpdateCalendarSettingsRequest) (*models.CalendarSettings, error)
+	ListCalendars(ctx context.Context) ([]string, error)
+	HoldSlot(ctx context.Context, req *models.HoldSlotRequest) (*models.Appointment, error)
+	ConfirmHold(ctx context.Context, req *models.ConfirmHoldRequest) (*models.Appointment, error)
+	RunHoldReaper(ctx context.Context)
+	UpdateAppointmentStatus(ctx context.Context, req *models.UpdateStatusRequest) (*models.Appointment, error)
+	CancelAppointment(ctx context.Context, id uuid.UUID, reason string) (*models.Appointment, error)
+	RestoreAppointment(ctx context.Context, id uuid.UUID) (*models.Appointment, error)
+	ListDeletedAppointments(ctx context.Context, req *models.ListAppointmentsRequest) (*models.ListAppointmentsResponse, error)
+	PurgeAppointment(ctx context.Context, id uuid.UUID) error
+	RunTrashPurger(ctx context.Context)
+	GetAppointmentHistory(ctx context.Context, id uuid.UUID) ([]models.AuditEvent, error)
+	ListAuditEvents(ctx context.Context, req *models.ListAuditEventsRequest) (*models.ListAuditEventsResponse, error)
+	CreateAPIKey(ctx context.Context, req *models.CreateAPIKeyRequest) (*models.APIKey, error)
+	ListAPIKeys(ctx context.Context) ([]models.APIKey, error)
+	RevokeAPIKey(ctx context.Context, id uuid.UUID) error
+	AuthenticateAPIKey(ctx context.Context, key string) (*auth.Principal, error)
+	SubscribeToUpdates(ctx context.Context) chan AppointmentEvent
 	UnsubscribeFromUpdates(ch chan AppointmentEvent)
 }
 
@@ -35,24 +72,60 @@ type AppointmentEvent struct {
 }
 
 type appointmentService struct {
-	repo        repository.AppointmentRepository
-	subscribers map[chan AppointmentEvent]bool
+	repo       repository.AppointmentRepository
+	calendars  repository.CalendarRepository
+	audit      repository.AuditRepository
+	feeds      repository.FeedRepository
+	apiKeys    repository.APIKeyRepository
+	scheduling config.SchedulingConfig
+	feedConfig config.FeedConfig
+	// subscribers are grouped by tenant and only receive events about
+	// appointments they may see
+	subscribers map[string]map[chan AppointmentEvent]access
 	mutex       sync.RWMutex
 }
 
-func NewAppointmentService(repo repository.AppointmentRepository) AppointmentService {
-	return &appointmentService{
+func NewAppointmentService(repo repository.AppointmentRepository, calendars repository.CalendarRepository, audit repository.AuditRepository, feeds repository.FeedRepository, apiKeys repository.APIKeyRepository, scheduling config.SchedulingConfig, feedConfig config.FeedConfig) AppointmentService {
+	return &tracedService{next: &appointmentService{
 		repo:        repo,
-		subscribers: make(map[chan AppointmentEvent]bool),
-	}
+		calendars:   calendars,
+		audit:       audit,
+		feeds:       feeds,
+		apiKeys:     apiKeys,
+		scheduling:  scheduling,
+		feedConfig:  feedConfig,
+		subscribers: make(map[string]map[chan AppointmentEvent]access),
+	}}
 }
 
 func (s *appointmentService) CreateAppointment(ctx context.Context, req *models.CreateAppointmentRequest) (*models.Appointment, error) {
+	if req.AllDay {
+		if err := s.resolveAllDay(req); err != nil {
+			logrus.WithError(err).Error("Invalid all-day appointment request")
+			return nil, err
+		}
+	}
+
 	// Validate request
 	if err := req.Validate(); err != nil {
 		logrus.WithError(err).Error("Invalid create appointment request")
 		return nil, err
 	}
+	if err := req.ValidateNotPast(); err != nil {
+		logrus.WithError(err).Error("Invalid create appointment request")
+		return nil, err
+	}
+
+	// All-day events were checked against policy when their dates were resolved
+	if !req.AllDay {
+		if err := ValidateAppointmentDuration(req.StartTime, req.EndTime); err != nil {
+			logrus.WithError(err).Error("Invalid create appointment request")
+			return nil, err
+		}
+	}
+	if err := authorize(accessFromContext(ctx).canCreateIn(req.CalendarID)); err != nil {
+		return nil, err
+	}
 
 	// Create appointment
 	appointment, err := s.repo.Create(ctx, req)
@@ -82,6 +155,9 @@ func (s *appointmentService) GetAppointment(ctx context.Context, id uuid.UUID) (
 		logrus.WithError(err).WithField("appointment_id", id).Error("Failed to get appointment")
 		return nil, err
 	}
+	if err := authorize(accessFromContext(ctx).canView(appointment)); err != nil {
+		return nil, err
+	}
 
 	return appointment, nil
 }
@@ -97,6 +173,9 @@ func (s *appointmentService) DeleteAppointment(ctx context.Context, id uuid.UUID
 		logrus.WithError(err).WithField("appointment_id", id).Error("Failed to get appointment for deletion")
 		return err
 	}
+	if err := authorize(accessFromContext(ctx).canEdit(appointment)); err != nil {
+		return err
+	}
 
 	// Delete appointment
 	err = s.repo.Delete(ctx, id)
@@ -127,6 +206,12 @@ func (s *appointmentService) ListAppointments(ctx context.Context, req *models.L
 	if req.Limit > 100 {
 		req.Limit = 100
 	}
+	for _, status := range req.Statuses {
+		if !status.IsValid() {
+			return nil, models.ErrInvalidStatus
+		}
+	}
+	req.Visible = accessFromContext(ctx).visible()
 
 	response, err := s.repo.List(ctx, req)
 	if err != nil {
@@ -137,12 +222,17 @@ func (s *appointmentService) ListAppointments(ctx context.Context, req *models.L
 	return response, nil
 }
 
-func (s *appointmentService) SubscribeToUpdates() chan AppointmentEvent {
+func (s *appointmentService) SubscribeToUpdates(ctx context.Context) chan AppointmentEvent {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
 	ch := make(chan AppointmentEvent, 100) // Buffer to prevent blocking
-	s.subscribers[ch] = true
+	tenant := requestinfo.FromContext(ctx).Tenant
+	if s.subscribers[tenant] == nil {
+		s.subscribers[tenant] = make(map[chan AppointmentEvent]access)
+	}
+	s.subscribers[tenant][ch] = accessFromContext(ctx)
+	metrics.StreamSubscribers.Inc()
 
 	logrus.Info("New subscriber added to appointment updates")
 	return ch
@@ -152,8 +242,17 @@ func (s *appointmentService) UnsubscribeFromUpdates(ch chan AppointmentEvent) {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
-	delete(s.subscribers, ch)
+	for tenant, subscribers := range s.subscribers {
+		if _, ok := subscribers[ch]; ok {
+			delete(subscribers, ch)
+			if len(subscribers) == 0 {
+				delete(s.subscribers, tenant)
+			}
+			break
+		}
+	}
 	close(ch)
+	metrics.StreamSubscribers.Dec()
 	logrus.Info("Subscriber removed from appointment updates")
 	
 }
@@ -162,12 +261,16 @@ func (s *appointmentService) notifySubscribers(event AppointmentEvent) {
 	s.mutex.RLock()
 	defer s.mutex.RUnlock()
 
-	for subscriber := range s.subscribers {
+	for subscriber, access := range s.subscribers[event.Appointment.TenantID] {
+		if !access.canView(event.Appointment) {
+			continue
+		}
 		select {
 		case subscriber <- event:
 		default:
 			// Channel is full, skip this subscriber to prevent blocking
 			logrus.Warn("Subscriber channel is full, skipping notification")
+			metrics.StreamEventsDropped.Inc()
 		}
 	}
 }
@@ -181,6 +284,18 @@ func (s *appointmentService) IsTimeSlotAvailable(ctx context.Context, startTime,
 	return !hasConflict, nil
 }
 
+// resolveAllDay turns the dates of an all-day request into times and applies
+// the duration limits unless policy exempts all-day events from them
+func (s *appointmentService) resolveAllDay(req *models.CreateAppointmentRequest) error {
+	if err := req.ResolveAllDay(); err != nil {
+		return err
+	}
+	if s.scheduling.AllDayExemptFromDurationLimits {
+		return nil
+	}
+	return ValidateAppointmentDuration(req.StartTime, req.EndTime)
+}
+
 // ValidateAppointmentTime validates appointment time constraints
 func ValidateAppointmentTime(startTime, endTime time.Time) error {
 	now := time.Now()
@@ -189,7 +304,12 @@ func ValidateAppointmentTime(startTime, endTime time.Time) error {
 	if startTime.Before(now) {
 		return models.ErrPastTime
 	}
-	
+
+	return ValidateAppointmentDuration(startTime, endTime)
+}
+
+// ValidateAppointmentDuration validates the range and length of an appointment
+func ValidateAppointmentDuration(startTime, endTime time.Time) error {
 	// Check if end time is before start time
 	if endTime.Before(startTime) || endTime.Equal(startTime) {
 		return models.ErrInvalidTimeRange
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pasDamola/schedule-management-system/internal/models"
+)
+
+// booking returns a one hour request starting at start
+func booking(start time.Time) *models.CreateAppointmentRequest {
+	return &models.CreateAppointmentRequest{
+		Title:     "Review",
+		StartTime: start,
+		EndTime:   start.Add(time.Hour),
+		TimeZone:  "UTC",
+	}
+}
+
+// allDay returns an all-day request for the single date of day
+func allDay(day time.Time) *models.CreateAppointmentRequest {
+	date := day.UTC().Format(models.DateLayout)
+	return &models.CreateAppointmentRequest{
+		Title:     "Offsite",
+		TimeZone:  "UTC",
+		AllDay:    true,
+		StartDate: date,
+		EndDate:   date,
+	}
+}
+
+func TestPastTimes(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-48 * time.Hour)
+	future := now.Add(48 * time.Hour)
+
+	existing := &models.Appointment{ID: uuid.New(), CalendarID: models.DefaultCalendarID}
+
+	tests := []struct {
+		name string
+		call func(s *appointmentService) error
+		want error
+	}{
+		{
+			name: "create in the future",
+			call: func(s *appointmentService) error {
+				_, err := s.CreateAppointment(context.Background(), booking(future))
+				return err
+			},
+		},
+		{
+			name: "create in the past",
+			call: func(s *appointmentService) error {
+				_, err := s.CreateAppointment(context.Background(), booking(past))
+				return err
+			},
+			want: models.ErrPastTime,
+		},
+		{
+			name: "create all-day today",
+			call: func(s *appointmentService) error {
+				_, err := s.CreateAppointment(context.Background(), allDay(now))
+				return err
+			},
+		},
+		{
+			name: "create all-day yesterday",
+			call: func(s *appointmentService) error {
+				_, err := s.CreateAppointment(context.Background(), allDay(now.AddDate(0, 0, -1)))
+				return err
+			},
+			want: models.ErrPastTime,
+		},
+		{
+			name: "hold in the past",
+			call: func(s *appointmentService) error {
+				_, err := s.HoldSlot(context.Background(), &models.HoldSlotRequest{
+					StartTime: past,
+					EndTime:   past.Add(time.Hour),
+				})
+				return err
+			},
+			want: models.ErrPastTime,
+		},
+		{
+			name: "batch item in the past",
+			call: func(s *appointmentService) error {
+				results, err := s.BatchCreateAppointments(context.Background(),
+					[]*models.CreateAppointmentRequest{booking(future), booking(past)}, models.BatchModeBestEffort)
+				if err != nil {
+					return err
+				}
+				return results[1].Err
+			},
+			want: models.ErrPastTime,
+		},
+		{
+			name: "replace with a past event",
+			call: func(s *appointmentService) error {
+				_, err := s.ReplaceAppointment(context.Background(), existing.ID, booking(past))
+				return err
+			},
+		},
+		{
+			name: "replace with an invalid range",
+			call: func(s *appointmentService) error {
+				req := booking(past)
+				req.EndTime = req.StartTime
+				_, err := s.ReplaceAppointment(context.Background(), existing.ID, req)
+				return err
+			},
+			want: models.ErrInvalidTimeRange,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestService(newFakeRepository(existing))
+			if err := tt.call(s); !errors.Is(err, tt.want) {
+				t.Errorf("error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
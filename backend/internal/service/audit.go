@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pasDamola/schedule-management-system/internal/models"
+	"github.com/sirupsen/logrus"
+)
+
+func (s *appointmentService) GetAppointmentHistory(ctx context.Context, id uuid.UUID) ([]models.AuditEvent, error) {
+	if id == uuid.Nil {
+		return nil, models.ErrInvalidID
+	}
+
+	events, err := s.audit.GetHistory(ctx, id)
+	if err != nil {
+		logrus.WithError(err).WithField("appointment_id", id).Error("Failed to get appointment history")
+		return nil, err
+	}
+
+	// History outlives purges, so only an ID that was never seen is unknown
+	if len(events) == 0 {
+		return nil, models.ErrAppointmentNotFound
+	}
+
+	// The latest snapshot decides, as the appointment may no longer exist
+	latest := events[len(events)-1]
+	snapshot := latest.After
+	if snapshot == nil {
+		snapshot = latest.Before
+	}
+	if snapshot != nil {
+		if err := authorize(accessFromContext(ctx).canView(snapshot)); err != nil {
+			return nil, err
+		}
+	} else if err := authorize(accessFromContext(ctx).canViewAll()); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func (s *appointmentService) ListAuditEvents(ctx context.Context, req *models.ListAuditEventsRequest) (*models.ListAuditEventsResponse, error) {
+	// Set defaults if not provided
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.Limit <= 0 {
+		req.Limit = 20
+	}
+	if req.Limit > 100 {
+		req.Limit = 100
+	}
+
+	if err := req.Validate(); err != nil {
+		logrus.WithError(err).Error("Invalid list audit events request")
+		return nil, err
+	}
+	if err := authorize(accessFromContext(ctx).canViewAll()); err != nil {
+		return nil, err
+	}
+
+	response, err := s.audit.List(ctx, req)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to list audit events")
+		return nil, err
+	}
+
+	return response, nil
+}
@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pasDamola/schedule-management-system/internal/models"
+	"github.com/sirupsen/logrus"
+)
+
+func (s *appointmentService) BatchCreateAppointments(ctx context.Context, reqs []*models.CreateAppointmentRequest, mode models.BatchMode) ([]models.BatchItemResult, error) {
+	if err := models.ValidateBatchSize(len(reqs)); err != nil {
+		return nil, err
+	}
+
+	access := accessFromContext(ctx)
+	results := make([]models.BatchItemResult, len(reqs))
+	for i, req := range reqs {
+		results[i].Index = i
+		results[i].Err = s.prepareBatchCreate(req)
+		if results[i].Err == nil {
+			// Batches book new appointments, so they may not lie in the past
+			results[i].Err = req.ValidateNotPast()
+		}
+		if results[i].Err == nil {
+			results[i].Err = authorize(access.canCreateIn(req.CalendarID))
+		}
+	}
+
+	if mode == models.BatchModeAtomic {
+		for _, result := range results {
+			if result.Err != nil {
+				logrus.WithError(result.Err).WithField("index", result.Index).Error("Invalid batch create request")
+				return nil, &models.BatchItemError{Index: result.Index, Err: result.Err}
+			}
+		}
+
+		appointments, err := s.repo.CreateBatch(ctx, reqs)
+		if err != nil {
+			logrus.WithError(err).Error("Failed to create appointment batch")
+			return nil, err
+		}
+
+		for i, appointment := range appointments {
+			results[i].ID = appointment.ID
+			results[i].Appointment = appointment
+			s.notifyCreated(appointment)
+		}
+		return results, nil
+	}
+
+	for i, req := range reqs {
+		if results[i].Err != nil {
+			continue
+		}
+
+		appointment, err := s.repo.Create(ctx, req)
+		if err != nil {
+			logrus.WithError(err).WithField("index", i).Error("Failed to create batch item")
+			results[i].Err = err
+			continue
+		}
+
+		results[i].ID = appointment.ID
+		results[i].Appointment = appointment
+		s.notifyCreated(appointment)
+	}
+
+	return results, nil
+}
+
+func (s *appointmentService) BatchDeleteAppointments(ctx context.Context, ids []uuid.UUID, mode models.BatchMode) ([]models.BatchItemResult, error) {
+	if err := models.ValidateBatchSize(len(ids)); err != nil {
+		return nil, err
+	}
+
+	results := make([]models.BatchItemResult, len(ids))
+	for i, id := range ids {
+		results[i].Index = i
+		results[i].ID = id
+		if id == uuid.Nil {
+			results[i].Err = models.ErrInvalidID
+		}
+	}
+
+	if mode == models.BatchModeAtomic {
+		for _, result := range results {
+			if result.Err != nil {
+				return nil, &models.BatchItemError{Index: result.Index, Err: result.Err}
+			}
+		}
+
+		// Each item is checked up front so a refusal leaves the batch untouched
+		if access := accessFromContext(ctx); !access.unrestricted() {
+			for i, id := range ids {
+				appointment, err := s.repo.GetByID(ctx, id)
+				if err == nil {
+					err = authorize(access.canEdit(appointment))
+				}
+				if err != nil {
+					return nil, &models.BatchItemError{Index: i, Err: err}
+				}
+			}
+		}
+
+		appointments, err := s.repo.DeleteBatch(ctx, ids)
+		if err != nil {
+			logrus.WithError(err).Error("Failed to delete appointment batch")
+			return nil, err
+		}
+
+		for i, appointment := range appointments {
+			results[i].Appointment = appointment
+			s.notifyDeleted(appointment)
+		}
+		return results, nil
+	}
+
+	for i, id := range ids {
+		if results[i].Err != nil {
+			continue
+		}
+
+		appointment, err := s.repo.GetByID(ctx, id)
+		if err == nil {
+			err = authorize(accessFromContext(ctx).canEdit(appointment))
+		}
+		if err == nil {
+			err = s.repo.Delete(ctx, id)
+		}
+		if err != nil {
+			logrus.WithError(err).WithField("appointment_id", id).Error("Failed to delete batch item")
+			results[i].Err = err
+			continue
+		}
+
+		results[i].Appointment = appointment
+		s.notifyDeleted(appointment)
+	}
+
+	return results, nil
+}
+
+// prepareBatchCreate applies the checks CreateAppointment makes to a single
+// request, so every item is validated before anything is written. Past times are
+// allowed, since imported and replaced appointments may lie in the past; callers
+// booking new appointments check ValidateNotPast themselves.
+func (s *appointmentService) prepareBatchCreate(req *models.CreateAppointmentRequest) error {
+	if req.AllDay {
+		if err := s.resolveAllDay(req); err != nil {
+			return err
+		}
+	}
+
+	if err := req.Validate(); err != nil {
+		return err
+	}
+
+	if !req.AllDay {
+		return ValidateAppointmentDuration(req.StartTime, req.EndTime)
+	}
+	return nil
+}
+
+func (s *appointmentService) notifyCreated(appointment *models.Appointment) {
+	s.notifySubscribers(AppointmentEvent{
+		Type:        EventTypeCreated,
+		Appointment: appointment,
+		Timestamp:   time.Now(),
+	})
+}
+
+func (s *appointmentService) notifyDeleted(appointment *models.Appointment) {
+	s.notifySubscribers(AppointmentEvent{
+		Type:        EventTypeDeleted,
+		Appointment: appointment,
+		Timestamp:   time.Now(),
+	})
+}
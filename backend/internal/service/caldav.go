@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pasDamola/schedule-management-system/internal/models"
+	"github.com/sirupsen/logrus"
+)
+
+// GetAppointmentByICalUID finds the live appointment published under uid in a
+// calendar, matching either its imported UID or its ID
+func (s *appointmentService) GetAppointmentByICalUID(ctx context.Context, calendarID, uid string) (*models.Appointment, error) {
+	found, err := s.repo.FindByICalUIDs(ctx, []string{uid})
+	if err != nil {
+		logrus.WithError(err).Error("Failed to look up appointment by iCalendar UID")
+		return nil, err
+	}
+
+	id, ok := found[uid]
+	if !ok {
+		return nil, models.ErrAppointmentNotFound
+	}
+
+	appointment, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if appointment.CalendarID != calendarID {
+		return nil, models.ErrAppointmentNotFound
+	}
+	if err := authorize(accessFromContext(ctx).canView(appointment)); err != nil {
+		return nil, err
+	}
+
+	return appointment, nil
+}
+
+// ReplaceAppointment overwrites an appointment with the contents of req, applying
+// the same validation and conflict checks as CreateAppointment, except that the
+// appointment may lie in the past. The calendar and UID of the appointment are kept.
+func (s *appointmentService) ReplaceAppointment(ctx context.Context, id uuid.UUID, req *models.CreateAppointmentRequest) (*models.Appointment, error) {
+	if id == uuid.Nil {
+		return nil, models.ErrInvalidID
+	}
+
+	if err := s.prepareBatchCreate(req); err != nil {
+		logrus.WithError(err).Error("Invalid replace appointment request")
+		return nil, err
+	}
+
+	current, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		logrus.WithError(err).WithField("appointment_id", id).Error("Failed to get appointment for replacement")
+		return nil, err
+	}
+	if err := authorize(accessFromContext(ctx).canEdit(current)); err != nil {
+		return nil, err
+	}
+
+	appointment, err := s.repo.Replace(ctx, id, req)
+	if err != nil {
+		logrus.WithError(err).WithField("appointment_id", id).Error("Failed to replace appointment")
+		return nil, err
+	}
+
+	s.notifySubscribers(AppointmentEvent{
+		Type:        EventTypeUpdated,
+		Appointment: appointment,
+		Timestamp:   time.Now(),
+	})
+
+	return appointment, nil
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+
+	"github.com/pasDamola/schedule-management-system/internal/models"
+	"github.com/sirupsen/logrus"
+)
+
+func (s *appointmentService) GetCalendarSettings(ctx context.Context, calendarID string) (*models.CalendarSettings, error) {
+	if calendarID == "" {
+		calendarID = models.DefaultCalendarID
+	}
+	if len(calendarID) > 100 {
+		return nil, models.ErrInvalidCalendarID
+	}
+	if err := authorize(accessFromContext(ctx).canViewCalendar(calendarID)); err != nil {
+		return nil, err
+	}
+
+	settings, err := s.calendars.GetSettings(ctx, calendarID)
+	if err != nil {
+		logrus.WithError(err).WithField("calendar_id", calendarID).Error("Failed to get calendar settings")
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+func (s *appointmentService) UpdateCalendarSettings(ctx context.Context, req *models.UpdateCalendarSettingsRequest) (*models.CalendarSettings, error) {
+	if req.CalendarID == "" {
+		req.CalendarID = models.DefaultCalendarID
+	}
+	if err := req.Validate(); err != nil {
+		logrus.WithError(err).Error("Invalid update calendar settings request")
+		return nil, err
+	}
+	if err := authorize(accessFromContext(ctx).canEditCalendar(req.CalendarID)); err != nil {
+		return nil, err
+	}
+
+	settings, err := s.calendars.UpdateSettings(ctx, req)
+	if err != nil {
+		logrus.WithError(err).WithField("calendar_id", req.CalendarID).Error("Failed to update calendar settings")
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+// ListCalendars returns the IDs of the calendars the caller may view
+func (s *appointmentService) ListCalendars(ctx context.Context) ([]string, error) {
+	calendarIDs, err := s.calendars.ListCalendarIDs(ctx)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to list calendars")
+		return nil, err
+	}
+
+	access := accessFromContext(ctx)
+	if access.visible() == nil {
+		return calendarIDs, nil
+	}
+	visible := make([]string, 0, len(calendarIDs))
+	for _, calendarID := range calendarIDs {
+		if access.canViewCalendar(calendarID) {
+			visible = append(visible, calendarID)
+		}
+	}
+	return visible, nil
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/pasDamola/schedule-management-system/internal/models"
+	"github.com/sirupsen/logrus"
+)
+
+// exportPageSize is the page size used to walk the repository during exports
+const exportPageSize = 100
+
+// ExportAppointments returns every appointment matching the filter, ignoring its
+// paging, in the same order as ListAppointments
+func (s *appointmentService) ExportAppointments(ctx context.Context, req *models.ListAppointmentsRequest) ([]models.Appointment, error) {
+	for _, status := range req.Statuses {
+		if !status.IsValid() {
+			return nil, models.ErrInvalidStatus
+		}
+	}
+
+	return s.listAll(ctx, req, models.MaxExportSize)
+}
+
+// ListCalendarAppointments returns every appointment in the calendar starting
+// at or after since, however many there are. Feeds and CalDAV collections
+// publish a calendar as a whole, so they are not held to the export limit.
+func (s *appointmentService) ListCalendarAppointments(ctx context.Context, calendarID string, since time.Time) ([]models.Appointment, error) {
+	return s.listAll(ctx, &models.ListAppointmentsRequest{CalendarID: calendarID, StartDate: since}, 0)
+}
+
+// listAll walks the pages of the appointments matching req that the caller may
+// see, failing with ErrExportTooLarge when there are more than limit of them.
+// A limit of 0 places no limit.
+func (s *appointmentService) listAll(ctx context.Context, req *models.ListAppointmentsRequest, limit int) ([]models.Appointment, error) {
+	pageReq := *req
+	pageReq.Visible = accessFromContext(ctx).visible()
+	pageReq.Limit = exportPageSize
+
+	var appointments []models.Appointment
+	for pageReq.Page = 1; ; pageReq.Page++ {
+		response, err := s.repo.List(ctx, &pageReq)
+		if err != nil {
+			logrus.WithError(err).Error("Failed to export appointments")
+			return nil, err
+		}
+		if limit > 0 && response.Total > limit {
+			return nil, models.ErrExportTooLarge
+		}
+
+		appointments = append(appointments, response.Appointments...)
+		if len(response.Appointments) < exportPageSize || len(appointments) >= response.Total {
+			break
+		}
+	}
+
+	return appointments, nil
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pasDamola/schedule-management-system/internal/models"
+)
+
+// calendarOf returns n hour-long appointments in calendarID, one a day from start
+func calendarOf(calendarID string, n int, start time.Time) []*models.Appointment {
+	appointments := make([]*models.Appointment, n)
+	for i := range appointments {
+		begin := start.AddDate(0, 0, i)
+		appointments[i] = &models.Appointment{
+			ID: uuid.New(), Title: "Standup", StartTime: begin, EndTime: begin.Add(time.Hour),
+			CalendarID: calendarID, Owner: "bob",
+		}
+	}
+	return appointments
+}
+
+func TestListCalendarAppointments(t *testing.T) {
+	start := time.Date(2020, 1, 1, 9, 0, 0, 0, time.UTC)
+	large := calendarOf("large", models.MaxExportSize+1, start)
+	small := calendarOf("small", 3, start)
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		calendarID string
+		since      time.Time
+		want       int
+	}{
+		{name: "beyond the export limit", ctx: context.Background(), calendarID: "large", want: models.MaxExportSize + 1},
+		{name: "since a date", ctx: context.Background(), calendarID: "small", since: start.AddDate(0, 0, 1), want: 2},
+		{name: "calendar the caller may view", ctx: withRoles("viewer:small"), calendarID: "small", want: 3},
+		{name: "calendar the caller may not view", ctx: withRoles("viewer:small"), calendarID: "large", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestService(newFakeRepository(append(large, small...)...))
+			appointments, err := s.ListCalendarAppointments(tt.ctx, tt.calendarID, tt.since)
+			if err != nil {
+				t.Fatalf("ListCalendarAppointments() error = %v", err)
+			}
+			if len(appointments) != tt.want {
+				t.Errorf("got %d appointments, want %d", len(appointments), tt.want)
+			}
+		})
+	}
+
+	s := newTestService(newFakeRepository(large...))
+	if _, err := s.ExportAppointments(context.Background(), &models.ListAppointmentsRequest{CalendarID: "large"}); !errors.Is(err, models.ErrExportTooLarge) {
+		t.Errorf("ExportAppointments() error = %v, want %v", err, models.ErrExportTooLarge)
+	}
+}
@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pasDamola/schedule-management-system/internal/models"
+	"github.com/pasDamola/schedule-management-system/internal/requestinfo"
+	"github.com/sirupsen/logrus"
+)
+
+// feedTokenBytes is the amount of randomness in a feed token
+const feedTokenBytes = 32
+
+func (s *appointmentService) CreateFeedToken(ctx context.Context, calendarID string) (*models.FeedToken, error) {
+	if calendarID == "" {
+		calendarID = models.DefaultCalendarID
+	}
+	if len(calendarID) > 100 {
+		return nil, models.ErrInvalidCalendarID
+	}
+	if err := authorize(accessFromContext(ctx).canEditCalendar(calendarID)); err != nil {
+		return nil, err
+	}
+
+	secret, hash, err := newFeedSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := s.feeds.Create(ctx, calendarID, hash)
+	if err != nil {
+		logrus.WithError(err).WithField("calendar_id", calendarID).Error("Failed to create feed token")
+		return nil, err
+	}
+
+	s.revealFeedToken(token, secret)
+	return token, nil
+}
+
+func (s *appointmentService) RotateFeedToken(ctx context.Context, id uuid.UUID) (*models.FeedToken, error) {
+	if id == uuid.Nil {
+		return nil, models.ErrInvalidID
+	}
+	if err := s.authorizeFeedToken(ctx, id); err != nil {
+		return nil, err
+	}
+
+	secret, hash, err := newFeedSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := s.feeds.Rotate(ctx, id, hash)
+	if err != nil {
+		logrus.WithError(err).WithField("feed_id", id).Error("Failed to rotate feed token")
+		return nil, err
+	}
+
+	s.revealFeedToken(token, secret)
+	return token, nil
+}
+
+func (s *appointmentService) RevokeFeedToken(ctx context.Context, id uuid.UUID) error {
+	if id == uuid.Nil {
+		return models.ErrInvalidID
+	}
+	if err := s.authorizeFeedToken(ctx, id); err != nil {
+		return err
+	}
+
+	if err := s.feeds.Revoke(ctx, id); err != nil {
+		logrus.WithError(err).WithField("feed_id", id).Error("Failed to revoke feed token")
+		return err
+	}
+
+	return nil
+}
+
+func (s *appointmentService) ListFeedTokens(ctx context.Context, calendarID string) ([]models.FeedToken, error) {
+	if calendarID == "" {
+		calendarID = models.DefaultCalendarID
+	}
+	if err := authorize(accessFromContext(ctx).canEditCalendar(calendarID)); err != nil {
+		return nil, err
+	}
+
+	tokens, err := s.feeds.List(ctx, calendarID)
+	if err != nil {
+		logrus.WithError(err).WithField("calendar_id", calendarID).Error("Failed to list feed tokens")
+		return nil, err
+	}
+
+	return tokens, nil
+}
+
+// authorizeFeedToken checks the caller may manage the calendar a token publishes
+func (s *appointmentService) authorizeFeedToken(ctx context.Context, id uuid.UUID) error {
+	token, err := s.feeds.GetByID(ctx, id)
+	if err != nil {
+		logrus.WithError(err).WithField("feed_id", id).Error("Failed to get feed token")
+		return err
+	}
+	return authorize(accessFromContext(ctx).canEditCalendar(token.CalendarID))
+}
+
+// GetFeed resolves a feed token to the calendar it publishes and the feed's
+// current version, without loading the appointments themselves
+func (s *appointmentService) GetFeed(ctx context.Context, token string) (*models.Feed, error) {
+	if token == "" {
+		return nil, models.ErrFeedNotFound
+	}
+
+	feedToken, err := s.feeds.GetByTokenHash(ctx, hashSecret(token))
+	if err != nil {
+		return nil, err
+	}
+	// The token alone decides the tenant, whatever the request named
+	ctx = requestinfo.WithTenant(ctx, feedToken.TenantID)
+
+	// Whole days keep the window, and so the ETag, stable between polls
+	since := time.Now().Add(-s.feedConfig.Lookback).UTC().Truncate(24 * time.Hour)
+	latest, count, err := s.feeds.GetVersion(ctx, feedToken.CalendarID, since)
+	if err != nil {
+		logrus.WithError(err).WithField("calendar_id", feedToken.CalendarID).Error("Failed to get feed version")
+		return nil, err
+	}
+
+	version := sha256.Sum256([]byte(fmt.Sprintf("%s|%d|%d|%d", feedToken.CalendarID, latest.UnixNano(), count, since.Unix())))
+	return &models.Feed{
+		TenantID:     feedToken.TenantID,
+		CalendarID:   feedToken.CalendarID,
+		Since:        since,
+		ETag:         `"` + hex.EncodeToString(version[:16]) + `"`,
+		LastModified: latest,
+	}, nil
+}
+
+// revealFeedToken fills in the secret and subscription URL, which are only
+// available right after the token is created or rotated
+func (s *appointmentService) revealFeedToken(token *models.FeedToken, secret string) {
+	token.Token = secret
+	token.URL = strings.TrimSuffix(s.feedConfig.BaseURL, "/") + "/feeds/" + secret + ".ics"
+}
+
+// newFeedSecret returns a random URL-safe token and the hash stored for it
+func newFeedSecret() (string, string, error) {
+	buf := make([]byte, feedTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", fmt.Errorf("failed to generate feed token: %v", err)
+	}
+	secret := base64.RawURLEncoding.EncodeToString(buf)
+	return secret, hashSecret(secret), nil
+}
+
+// hashSecret returns the SHA-256 hash stored for a feed token or API key
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
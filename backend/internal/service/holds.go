@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pasDamola/schedule-management-system/internal/models"
+	"github.com/pasDamola/schedule-management-system/internal/requestinfo"
+	"github.com/sirupsen/logrus"
+)
+
+// defaultHoldTitle is used for holds created without a title
+const defaultHoldTitle = "Hold"
+
+func (s *appointmentService) HoldSlot(ctx context.Context, req *models.HoldSlotRequest) (*models.Appointment, error) {
+	ttl := req.TTL
+	if ttl <= 0 {
+		ttl = s.scheduling.HoldTTL
+	}
+	if ttl > s.scheduling.MaxHoldTTL {
+		return nil, models.ErrInvalidHoldTTL
+	}
+
+	if err := ValidateAppointmentTime(req.StartTime, req.EndTime); err != nil {
+		logrus.WithError(err).Error("Invalid hold slot request")
+		return nil, err
+	}
+
+	title := req.Title
+	if title == "" {
+		title = defaultHoldTitle
+	}
+
+	expiresAt := time.Now().Add(ttl)
+	createReq := &models.CreateAppointmentRequest{
+		Title:         title,
+		StartTime:     req.StartTime,
+		EndTime:       req.EndTime,
+		CalendarID:    req.CalendarID,
+		TimeZone:      req.TimeZone,
+		HoldExpiresAt: &expiresAt,
+	}
+	if err := createReq.Validate(); err != nil {
+		logrus.WithError(err).Error("Invalid hold slot request")
+		return nil, err
+	}
+	if err := authorize(accessFromContext(ctx).canCreateIn(createReq.CalendarID)); err != nil {
+		return nil, err
+	}
+
+	hold, err := s.repo.Create(ctx, createReq)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to hold slot")
+		return nil, err
+	}
+
+	// Holds block time, so other clients need to see them
+	s.notifySubscribers(AppointmentEvent{
+		Type:        EventTypeCreated,
+		Appointment: hold,
+		Timestamp:   time.Now(),
+	})
+
+	logrus.WithFields(logrus.Fields{
+		"appointment_id":  hold.ID,
+		"hold_expires_at": expiresAt,
+	}).Info("Slot held successfully")
+	return hold, nil
+}
+
+func (s *appointmentService) ConfirmHold(ctx context.Context, req *models.ConfirmHoldRequest) (*models.Appointment, error) {
+	if req.ID == uuid.Nil {
+		return nil, models.ErrInvalidID
+	}
+
+	hold, err := s.repo.GetByID(ctx, req.ID)
+	if err != nil {
+		logrus.WithError(err).WithField("appointment_id", req.ID).Error("Failed to get hold for confirmation")
+		return nil, err
+	}
+	if err := authorize(accessFromContext(ctx).canEdit(hold)); err != nil {
+		return nil, err
+	}
+
+	appointment, err := s.repo.ConfirmHold(ctx, req)
+	if err != nil {
+		logrus.WithError(err).WithField("appointment_id", req.ID).Error("Failed to confirm hold")
+		return nil, err
+	}
+
+	s.notifySubscribers(AppointmentEvent{
+		Type:        EventTypeUpdated,
+		Appointment: appointment,
+		Timestamp:   time.Now(),
+	})
+
+	logrus.WithField("appointment_id", appointment.ID).Info("Hold confirmed successfully")
+	return appointment, nil
+}
+
+// RunHoldReaper deletes expired holds every HoldReaperInterval until ctx is cancelled
+func (s *appointmentService) RunHoldReaper(ctx context.Context) {
+	ctx = requestinfo.WithSystemActor(ctx)
+	ticker := time.NewTicker(s.scheduling.HoldReaperInterval)
+	defer ticker.Stop()
+
+	logrus.WithField("interval", s.scheduling.HoldReaperInterval).Info("Hold reaper started")
+
+	for {
+		select {
+		case <-ticker.C:
+			s.reapExpiredHolds(ctx)
+		case <-ctx.Done():
+			logrus.Info("Hold reaper stopped")
+			return
+		}
+	}
+}
+
+func (s *appointmentService) reapExpiredHolds(ctx context.Context) {
+	holds, err := s.repo.DeleteExpiredHolds(ctx)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to reap expired holds")
+		return
+	}
+
+	for i := range holds {
+		s.notifySubscribers(AppointmentEvent{
+			Type:        EventTypeDeleted,
+			Appointment: &holds[i],
+			Timestamp:   time.Now(),
+		})
+	}
+
+	if len(holds) > 0 {
+		logrus.WithField("count", len(holds)).Info("Expired holds reaped")
+	}
+}
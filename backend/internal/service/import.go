@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/google/uuid"
+	"github.com/pasDamola/schedule-management-system/internal/icalendar"
+	"github.com/pasDamola/schedule-management-system/internal/models"
+	"github.com/sirupsen/logrus"
+)
+
+// ImportICS creates an appointment for every VEVENT in data. Events whose UID was
+// already imported, or is the ID of an existing appointment, are skipped as
+// duplicates; the rest go through the same validation and conflict checks as
+// CreateAppointment. Past events are imported as well, so a calendar's history
+// can be moved over. Nothing is written on a dry run.
+func (s *appointmentService) ImportICS(ctx context.Context, data io.Reader, opts *models.ImportOptions) (*models.ImportReport, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	if err := authorize(accessFromContext(ctx).canCreateIn(opts.CalendarID)); err != nil {
+		return nil, err
+	}
+
+	events, err := icalendar.Decode(data, opts.TimeZone)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to decode iCalendar import")
+		return nil, models.ErrInvalidICS
+	}
+
+	uids := make([]string, 0, len(events))
+	for _, event := range events {
+		if event.UID != "" {
+			uids = append(uids, event.UID)
+		}
+	}
+	existing, err := s.repo.FindByICalUIDs(ctx, uids)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to look up imported events")
+		return nil, err
+	}
+
+	report := &models.ImportReport{
+		DryRun:  opts.DryRun,
+		Results: make([]models.ImportEventResult, len(events)),
+	}
+
+	// Events that pass every check before touching the database, by result index
+	var pending []int
+	var reqs []*models.CreateAppointmentRequest
+	seen := make(map[string]bool)
+	for i, event := range events {
+		result := &report.Results[i]
+		result.UID = event.UID
+		result.Title = event.Request.Title
+
+		event.Request.CalendarID = opts.CalendarID
+		if event.Err == nil {
+			event.Err = s.prepareBatchCreate(event.Request)
+		}
+		result.StartTime = event.Request.StartTime
+		result.EndTime = event.Request.EndTime
+
+		switch {
+		case event.UID != "" && existing[event.UID] != uuid.Nil:
+			result.Outcome = models.ImportOutcomeDuplicate
+			result.AppointmentID = existing[event.UID]
+		case event.UID != "" && seen[event.UID]:
+			result.Outcome = models.ImportOutcomeDuplicate
+		case event.Err != nil:
+			result.Outcome = models.ImportOutcomeFailed
+			result.Err = event.Err
+		default:
+			pending = append(pending, i)
+			reqs = append(reqs, event.Request)
+		}
+		seen[event.UID] = true
+	}
+
+	if len(reqs) > 0 {
+		created, err := s.repo.CreateEach(ctx, reqs, opts.DryRun)
+		if err != nil {
+			logrus.WithError(err).Error("Failed to import appointments")
+			return nil, err
+		}
+
+		for j, item := range created {
+			result := &report.Results[pending[j]]
+			switch {
+			case errors.Is(item.Err, models.ErrAppointmentConflict):
+				result.Outcome = models.ImportOutcomeConflict
+				result.Err = item.Err
+			case item.Err != nil:
+				result.Outcome = models.ImportOutcomeFailed
+				result.Err = item.Err
+			default:
+				result.Outcome = models.ImportOutcomeImported
+				if !opts.DryRun {
+					result.AppointmentID = item.Appointment.ID
+					s.notifyCreated(item.Appointment)
+				}
+			}
+		}
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"dry_run":    opts.DryRun,
+		"imported":   report.Count(models.ImportOutcomeImported),
+		"duplicates": report.Count(models.ImportOutcomeDuplicate),
+		"conflicts":  report.Count(models.ImportOutcomeConflict),
+		"failed":     report.Count(models.ImportOutcomeFailed),
+	}).Info("iCalendar import finished")
+	return report, nil
+}
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/pasDamola/schedule-management-system/internal/models"
+)
+
+// calendarWith wraps VEVENT bodies in a VCALENDAR, joining lines with CRLF
+func calendarWith(events ...string) string {
+	lines := []string{"BEGIN:VCALENDAR", "VERSION:2.0", "PRODID:-//test//EN"}
+	for _, event := range events {
+		lines = append(lines, "BEGIN:VEVENT")
+		lines = append(lines, strings.Split(event, "\n")...)
+		lines = append(lines, "END:VEVENT")
+	}
+	lines = append(lines, "END:VCALENDAR")
+	return strings.Join(lines, "\r\n") + "\r\n"
+}
+
+func TestImportICS(t *testing.T) {
+	tests := []struct {
+		name   string
+		events []string
+		want   []models.ImportOutcome
+	}{
+		{
+			name:   "past event",
+			events: []string{"UID:past@example.com\nDTSTAMP:20200101T000000Z\nSUMMARY:Kickoff\nDTSTART:20200302T090000Z\nDTEND:20200302T100000Z"},
+			want:   []models.ImportOutcome{models.ImportOutcomeImported},
+		},
+		{
+			name:   "past all-day event",
+			events: []string{"UID:day@example.com\nDTSTAMP:20200101T000000Z\nSUMMARY:Offsite\nDTSTART;VALUE=DATE:20200302\nDTEND;VALUE=DATE:20200303"},
+			want:   []models.ImportOutcome{models.ImportOutcomeImported},
+		},
+		{
+			name: "duplicate UID in the file",
+			events: []string{
+				"UID:twice@example.com\nDTSTAMP:20200101T000000Z\nSUMMARY:One\nDTSTART:20200302T090000Z\nDTEND:20200302T100000Z",
+				"UID:twice@example.com\nDTSTAMP:20200101T000000Z\nSUMMARY:Two\nDTSTART:20200303T090000Z\nDTEND:20200303T100000Z",
+			},
+			want: []models.ImportOutcome{models.ImportOutcomeImported, models.ImportOutcomeDuplicate},
+		},
+		{
+			name:   "too short",
+			events: []string{"UID:short@example.com\nDTSTAMP:20200101T000000Z\nSUMMARY:Call\nDTSTART:20200302T090000Z\nDTEND:20200302T090500Z"},
+			want:   []models.ImportOutcome{models.ImportOutcomeFailed},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeRepository()
+			s := newTestService(repo)
+			report, err := s.ImportICS(context.Background(), strings.NewReader(calendarWith(tt.events...)), &models.ImportOptions{})
+			if err != nil {
+				t.Fatalf("ImportICS() error = %v", err)
+			}
+			if len(report.Results) != len(tt.want) {
+				t.Fatalf("got %d results, want %d", len(report.Results), len(tt.want))
+			}
+			for i, result := range report.Results {
+				if result.Outcome != tt.want[i] {
+					t.Errorf("event %d: outcome = %s (%v), want %s", i, result.Outcome, result.Err, tt.want[i])
+				}
+			}
+			if got, want := len(repo.appointments), report.Count(models.ImportOutcomeImported); got != want {
+				t.Errorf("stored %d appointments, want %d", got, want)
+			}
+		})
+	}
+}
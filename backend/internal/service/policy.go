@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/pasDamola/schedule-management-system/internal/auth"
+	"github.com/pasDamola/schedule-management-system/internal/models"
+)
+
+// Roles granted through the token's roles claim. Editor and viewer apply to
+// every calendar, or to a single one when written as "editor:<calendar_id>".
+const (
+	RoleAdmin  = "admin"
+	RoleEditor = "editor"
+	RoleViewer = "viewer"
+)
+
+// access is what the caller may do. Editors may create appointments in their
+// calendars, which they then own. Owners and editors may change an appointment,
+// viewers may only see it, and admins may do anything. Calls without a principal, made while
+// authentication is off or by the server itself, are not restricted.
+type access struct {
+	principal *auth.Principal
+	admin     bool
+	editAll   bool
+	viewAll   bool
+	edit      map[string]bool
+	view      map[string]bool
+}
+
+func accessFromContext(ctx context.Context) access {
+	principal, ok := auth.FromContext(ctx)
+	if !ok {
+		return access{}
+	}
+
+	a := access{
+		principal: principal,
+		edit:      make(map[string]bool),
+		view:      make(map[string]bool),
+	}
+	for _, role := range principal.Roles {
+		name, calendarID, scoped := strings.Cut(role, ":")
+		switch {
+		case name == RoleAdmin && !scoped:
+			a.admin = true
+		case name == RoleEditor && !scoped:
+			a.editAll = true
+		case name == RoleViewer && !scoped:
+			a.viewAll = true
+		case name == RoleEditor:
+			a.edit[calendarID] = true
+		case name == RoleViewer:
+			a.view[calendarID] = true
+		}
+	}
+	return a
+}
+
+func (a access) unrestricted() bool {
+	return a.principal == nil || a.admin
+}
+
+// isAdmin requires an authenticated admin, for operations that stay closed while
+// authentication is off
+func (a access) isAdmin() bool {
+	return a.principal != nil && a.admin
+}
+
+func (a access) owns(appointment *models.Appointment) bool {
+	return a.principal != nil && appointment.Owner == a.principal.Subject
+}
+
+// canEditCalendar allows changing any appointment in the calendar and its settings
+func (a access) canEditCalendar(calendarID string) bool {
+	return a.unrestricted() || a.editAll || a.edit[calendarID]
+}
+
+// canCreateIn allows adding appointments to the calendar; an empty ID stands for
+// the default calendar, as when the appointment is stored
+func (a access) canCreateIn(calendarID string) bool {
+	if calendarID == "" {
+		calendarID = models.DefaultCalendarID
+	}
+	return a.canEditCalendar(calendarID)
+}
+
+// canViewCalendar allows seeing the calendar's settings and every appointment in it
+func (a access) canViewCalendar(calendarID string) bool {
+	return a.canViewAll() || a.edit[calendarID] || a.view[calendarID]
+}
+
+// canViewAll allows seeing every appointment, such as in statistics or the audit log
+func (a access) canViewAll() bool {
+	return a.unrestricted() || a.editAll || a.viewAll
+}
+
+func (a access) canView(appointment *models.Appointment) bool {
+	return a.owns(appointment) || a.canViewCalendar(appointment.CalendarID)
+}
+
+func (a access) canEdit(appointment *models.Appointment) bool {
+	return a.owns(appointment) || a.canEditCalendar(appointment.CalendarID)
+}
+
+// visible returns the filter listing what the caller may see, or nil when they
+// may see everything
+func (a access) visible() *models.AccessFilter {
+	if a.canViewAll() {
+		return nil
+	}
+
+	calendarIDs := make([]string, 0, len(a.edit)+len(a.view))
+	for calendarID := range a.edit {
+		calendarIDs = append(calendarIDs, calendarID)
+	}
+	for calendarID := range a.view {
+		if !a.edit[calendarID] {
+			calendarIDs = append(calendarIDs, calendarID)
+		}
+	}
+	sort.Strings(calendarIDs)
+	return &models.AccessFilter{Owner: a.principal.Subject, CalendarIDs: calendarIDs}
+}
+
+// authorize returns ErrPermissionDenied unless allowed
+func authorize(allowed bool) error {
+	if !allowed {
+		return models.ErrPermissionDenied
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/pasDamola/schedule-management-system/internal/auth"
+	"github.com/pasDamola/schedule-management-system/internal/models"
+	"github.com/pasDamola/schedule-management-system/internal/repository"
+)
+
+// withRoles returns a context authenticated as subject "alice" holding roles
+func withRoles(roles ...string) context.Context {
+	return auth.NewContext(context.Background(), &auth.Principal{Subject: "alice", Roles: roles})
+}
+
+func TestAccess(t *testing.T) {
+	own := &models.Appointment{CalendarID: "cal-b", Owner: "alice"}
+	other := &models.Appointment{CalendarID: "cal-b", Owner: "bob"}
+	inA := &models.Appointment{CalendarID: "cal-a", Owner: "bob"}
+
+	type checks struct {
+		createInA, createDefault, viewCalendarB       bool
+		viewAll, viewOther, editOther, editOwn, viewA bool
+		editA                                         bool
+	}
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want checks
+	}{
+		{
+			name: "no principal",
+			ctx:  context.Background(),
+			want: checks{true, true, true, true, true, true, true, true, true},
+		},
+		{
+			name: "admin",
+			ctx:  withRoles("admin"),
+			want: checks{true, true, true, true, true, true, true, true, true},
+		},
+		{
+			name: "no roles",
+			ctx:  withRoles(),
+			want: checks{editOwn: true},
+		},
+		{
+			name: "editor",
+			ctx:  withRoles("editor"),
+			want: checks{true, true, true, true, true, true, true, true, true},
+		},
+		{
+			name: "viewer",
+			ctx:  withRoles("viewer"),
+			want: checks{viewCalendarB: true, viewAll: true, viewOther: true, editOwn: true, viewA: true},
+		},
+		{
+			name: "editor of one calendar",
+			ctx:  withRoles("editor:cal-a"),
+			want: checks{createInA: true, editOwn: true, viewA: true, editA: true},
+		},
+		{
+			name: "viewer of one calendar",
+			ctx:  withRoles("viewer:cal-b"),
+			want: checks{viewCalendarB: true, viewOther: true, editOwn: true},
+		},
+		{
+			name: "editor of the default calendar",
+			ctx:  withRoles("editor:" + models.DefaultCalendarID),
+			want: checks{createDefault: true, editOwn: true},
+		},
+		{
+			name: "unknown and scoped admin roles",
+			ctx:  withRoles("owner", "admin:cal-a"),
+			want: checks{editOwn: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := accessFromContext(tt.ctx)
+			got := checks{
+				createInA:     a.canCreateIn("cal-a"),
+				createDefault: a.canCreateIn(""),
+				viewCalendarB: a.canViewCalendar("cal-b"),
+				viewAll:       a.canViewAll(),
+				viewOther:     a.canView(other),
+				editOther:     a.canEdit(other),
+				editOwn:       a.canEdit(own) && a.canView(own),
+				viewA:         a.canView(inA),
+				editA:         a.canEdit(inA),
+			}
+			if got != tt.want {
+				t.Errorf("checks = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVisible(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want *models.AccessFilter
+	}{
+		{name: "no principal", ctx: context.Background()},
+		{name: "viewer", ctx: withRoles("viewer")},
+		{name: "no roles", ctx: withRoles(), want: &models.AccessFilter{Owner: "alice", CalendarIDs: []string{}}},
+		{
+			name: "scoped roles",
+			ctx:  withRoles("viewer:cal-c", "editor:cal-a", "viewer:cal-a"),
+			want: &models.AccessFilter{Owner: "alice", CalendarIDs: []string{"cal-a", "cal-c"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := accessFromContext(tt.ctx).visible(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("visible() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// fakeCalendars lists a fixed set of calendar IDs
+type fakeCalendars struct {
+	repository.CalendarRepository
+	calendarIDs []string
+}
+
+func (c *fakeCalendars) ListCalendarIDs(ctx context.Context) ([]string, error) {
+	return c.calendarIDs, nil
+}
+
+func TestListCalendars(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want []string
+	}{
+		{name: "no principal", ctx: context.Background(), want: []string{"cal-a", "cal-b", "default"}},
+		{name: "viewer", ctx: withRoles("viewer"), want: []string{"cal-a", "cal-b", "default"}},
+		{name: "editor of one calendar", ctx: withRoles("editor:cal-a"), want: []string{"cal-a"}},
+		{name: "viewer of one calendar", ctx: withRoles("viewer:cal-b"), want: []string{"cal-b"}},
+		{name: "no roles", ctx: withRoles(), want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestService(newFakeRepository())
+			s.calendars = &fakeCalendars{calendarIDs: []string{"cal-a", "cal-b", "default"}}
+			got, err := s.ListCalendars(tt.ctx)
+			if err != nil {
+				t.Fatalf("ListCalendars() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ListCalendars() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/pasDamola/schedule-management-system/internal/models"
+	"github.com/pasDamola/schedule-management-system/internal/records"
+	"github.com/sirupsen/logrus"
+)
+
+// ImportRecords creates an appointment for every row of a CSV or NDJSON file. Rows
+// go through the same validation and conflict checks as CreateAppointment, except
+// that they may lie in the past, so exported rows can be edited and imported again. In
+// atomic mode every row is checked before anything is written, so the report
+// lists all failing rows and nothing is created unless they all pass; in
+// best-effort mode each valid row is created on its own. Nothing is written on a
+// dry run.
+func (s *appointmentService) ImportRecords(ctx context.Context, data io.Reader, opts *models.RecordImportOptions) (*models.RecordImportReport, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	rows, err := records.Decode(data, opts)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to decode record import")
+		return nil, err
+	}
+
+	report := &models.RecordImportReport{
+		Mode:    opts.Mode,
+		DryRun:  opts.DryRun,
+		Results: make([]models.RecordResult, len(rows)),
+	}
+
+	access := accessFromContext(ctx)
+
+	// Rows that pass every check before touching the database, by result index
+	var pending []int
+	var reqs []*models.CreateAppointmentRequest
+	for i, row := range rows {
+		result := &report.Results[i]
+		result.Line = row.Line
+
+		if row.Err == nil {
+			row.Err = s.prepareBatchCreate(row.Request)
+		}
+		if row.Err == nil {
+			row.Err = authorize(access.canCreateIn(row.Request.CalendarID))
+		}
+		if row.Err != nil {
+			result.Err = row.Err
+			continue
+		}
+
+		pending = append(pending, i)
+		reqs = append(reqs, row.Request)
+	}
+
+	if len(reqs) > 0 {
+		// Atomic imports are checked in full before the real write below
+		checkOnly := opts.DryRun || opts.Mode == models.BatchModeAtomic
+		created, err := s.repo.CreateEach(ctx, reqs, checkOnly)
+		if err != nil {
+			logrus.WithError(err).Error("Failed to import records")
+			return nil, err
+		}
+
+		for j, item := range created {
+			result := &report.Results[pending[j]]
+			result.Err = item.Err
+			if item.Err == nil && !checkOnly {
+				result.ID = item.Appointment.ID
+				report.Committed = true
+				s.notifyCreated(item.Appointment)
+			}
+		}
+
+		if opts.Mode == models.BatchModeAtomic && !opts.DryRun && report.Failed() == 0 {
+			if err := s.commitRecords(ctx, report, pending, reqs); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"format":    opts.Format,
+		"dry_run":   opts.DryRun,
+		"committed": report.Committed,
+		"rows":      len(report.Results),
+		"failed":    report.Failed(),
+	}).Info("Record import finished")
+	return report, nil
+}
+
+// commitRecords creates the rows of an atomic import in one transaction. A row
+// that started to conflict since it was checked is reported and nothing is created.
+func (s *appointmentService) commitRecords(ctx context.Context, report *models.RecordImportReport, pending []int, reqs []*models.CreateAppointmentRequest) error {
+	appointments, err := s.repo.CreateBatch(ctx, reqs)
+	if err != nil {
+		var itemErr *models.BatchItemError
+		if errors.As(err, &itemErr) {
+			report.Results[pending[itemErr.Index]].Err = itemErr.Err
+			return nil
+		}
+		logrus.WithError(err).Error("Failed to import records")
+		return err
+	}
+
+	for j, appointment := range appointments {
+		report.Results[pending[j]].ID = appointment.ID
+		s.notifyCreated(appointment)
+	}
+	report.Committed = true
+	return nil
+}
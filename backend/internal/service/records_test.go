@@ -0,0 +1,77 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pasDamola/schedule-management-system/internal/models"
+	"github.com/pasDamola/schedule-management-system/internal/records"
+)
+
+// TestImportRecordsRoundTrip exports past appointments and imports the file
+// again, as staff do when editing rows in a spreadsheet
+func TestImportRecordsRoundTrip(t *testing.T) {
+	day := time.Date(2020, 3, 2, 0, 0, 0, 0, time.UTC)
+	start := day.Add(9 * time.Hour)
+	exported := []models.Appointment{
+		{
+			ID: uuid.New(), Title: "Kickoff", StartTime: start, EndTime: start.Add(time.Hour),
+			CalendarID: "team", TimeZone: "UTC", Status: models.StatusCompleted,
+		},
+		{
+			ID: uuid.New(), Title: "Offsite", StartTime: day.AddDate(0, 0, 1), EndTime: day.AddDate(0, 0, 3),
+			CalendarID: "team", TimeZone: "UTC", AllDay: true, Status: models.StatusCompleted,
+		},
+	}
+
+	tests := []struct {
+		name   string
+		format models.RecordFormat
+		mode   models.BatchMode
+	}{
+		{name: "CSV atomic", format: models.RecordFormatCSV, mode: models.BatchModeAtomic},
+		{name: "CSV best effort", format: models.RecordFormatCSV, mode: models.BatchModeBestEffort},
+		{name: "NDJSON atomic", format: models.RecordFormatNDJSON, mode: models.BatchModeAtomic},
+		{name: "NDJSON best effort", format: models.RecordFormatNDJSON, mode: models.BatchModeBestEffort},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var file bytes.Buffer
+			if err := records.Encode(&file, tt.format, exported); err != nil {
+				t.Fatalf("Encode() error = %v", err)
+			}
+
+			repo := newFakeRepository()
+			s := newTestService(repo)
+			report, err := s.ImportRecords(context.Background(), &file, &models.RecordImportOptions{Format: tt.format, Mode: tt.mode})
+			if err != nil {
+				t.Fatalf("ImportRecords() error = %v", err)
+			}
+			for _, result := range report.Results {
+				if result.Err != nil {
+					t.Errorf("line %d: %v", result.Line, result.Err)
+				}
+			}
+			if !report.Committed || len(repo.appointments) != len(exported) {
+				t.Fatalf("committed = %v with %d appointments, want %d", report.Committed, len(repo.appointments), len(exported))
+			}
+
+			for _, want := range exported {
+				found := false
+				for _, got := range repo.appointments {
+					if got.Title == want.Title && got.StartTime.Equal(want.StartTime) && got.EndTime.Equal(want.EndTime) &&
+						got.CalendarID == want.CalendarID && got.AllDay == want.AllDay {
+						found = true
+					}
+				}
+				if !found {
+					t.Errorf("%s was not imported as exported", want.Title)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"io"
+	"os"
+	"slices"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pasDamola/schedule-management-system/internal/config"
+	"github.com/pasDamola/schedule-management-system/internal/models"
+	"github.com/pasDamola/schedule-management-system/internal/repository"
+	"github.com/sirupsen/logrus"
+)
+
+func TestMain(m *testing.M) {
+	logrus.SetOutput(io.Discard)
+	os.Exit(m.Run())
+}
+
+// fakeRepository keeps appointments in memory. Methods the tests do not use
+// fall through to the embedded nil interface and panic.
+type fakeRepository struct {
+	repository.AppointmentRepository
+	appointments map[uuid.UUID]*models.Appointment
+	// statistics is returned by GetStatistics, which records its request
+	statistics        models.StatisticsResponse
+	statisticsRequest models.StatisticsRequest
+}
+
+func newFakeRepository(appointments ...*models.Appointment) *fakeRepository {
+	r := &fakeRepository{appointments: make(map[uuid.UUID]*models.Appointment)}
+	for _, appointment := range appointments {
+		r.appointments[appointment.ID] = appointment
+	}
+	return r
+}
+
+func (r *fakeRepository) appointmentFrom(req *models.CreateAppointmentRequest) *models.Appointment {
+	calendarID := req.CalendarID
+	if calendarID == "" {
+		calendarID = models.DefaultCalendarID
+	}
+	return &models.Appointment{
+		ID:         uuid.New(),
+		Title:      req.Title,
+		StartTime:  req.StartTime,
+		EndTime:    req.EndTime,
+		CalendarID: calendarID,
+		TimeZone:   req.TimeZone,
+		AllDay:     req.AllDay,
+		Status:     models.StatusScheduled,
+		ICalUID:    req.ICalUID,
+	}
+}
+
+func (r *fakeRepository) Create(ctx context.Context, req *models.CreateAppointmentRequest) (*models.Appointment, error) {
+	appointment := r.appointmentFrom(req)
+	r.appointments[appointment.ID] = appointment
+	return appointment, nil
+}
+
+func (r *fakeRepository) CreateBatch(ctx context.Context, reqs []*models.CreateAppointmentRequest) ([]*models.Appointment, error) {
+	appointments := make([]*models.Appointment, len(reqs))
+	for i, req := range reqs {
+		appointments[i], _ = r.Create(ctx, req)
+	}
+	return appointments, nil
+}
+
+func (r *fakeRepository) CreateEach(ctx context.Context, reqs []*models.CreateAppointmentRequest, dryRun bool) ([]models.BatchItemResult, error) {
+	results := make([]models.BatchItemResult, len(reqs))
+	for i, req := range reqs {
+		results[i].Index = i
+		if dryRun {
+			results[i].Appointment = r.appointmentFrom(req)
+			continue
+		}
+		results[i].Appointment, _ = r.Create(ctx, req)
+		results[i].ID = results[i].Appointment.ID
+	}
+	return results, nil
+}
+
+func (r *fakeRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Appointment, error) {
+	appointment, ok := r.appointments[id]
+	if !ok {
+		return nil, models.ErrAppointmentNotFound
+	}
+	return appointment, nil
+}
+
+func (r *fakeRepository) Replace(ctx context.Context, id uuid.UUID, req *models.CreateAppointmentRequest) (*models.Appointment, error) {
+	current, ok := r.appointments[id]
+	if !ok {
+		return nil, models.ErrAppointmentNotFound
+	}
+	appointment := r.appointmentFrom(req)
+	appointment.ID = id
+	appointment.CalendarID = current.CalendarID
+	r.appointments[id] = appointment
+	return appointment, nil
+}
+
+// List pages through the appointments in start time order, applying the
+// calendar, start date and access filters
+func (r *fakeRepository) List(ctx context.Context, req *models.ListAppointmentsRequest) (*models.ListAppointmentsResponse, error) {
+	var matching []models.Appointment
+	for _, appointment := range r.appointments {
+		switch {
+		case req.CalendarID != "" && appointment.CalendarID != req.CalendarID:
+		case !req.StartDate.IsZero() && appointment.StartTime.Before(req.StartDate):
+		case req.Visible != nil && appointment.Owner != req.Visible.Owner &&
+			!slices.Contains(req.Visible.CalendarIDs, appointment.CalendarID):
+		default:
+			matching = append(matching, *appointment)
+		}
+	}
+	sort.Slice(matching, func(i, j int) bool { return matching[i].StartTime.Before(matching[j].StartTime) })
+
+	response := &models.ListAppointmentsResponse{Total: len(matching), Page: req.Page, Limit: req.Limit}
+	from := min((req.Page-1)*req.Limit, len(matching))
+	to := min(from+req.Limit, len(matching))
+	response.Appointments = matching[from:to]
+	return response, nil
+}
+
+func (r *fakeRepository) FindByICalUIDs(ctx context.Context, uids []string) (map[string]uuid.UUID, error) {
+	found := make(map[string]uuid.UUID)
+	for _, appointment := range r.appointments {
+		for _, uid := range uids {
+			if appointment.ICalUID == uid {
+				found[uid] = appointment.ID
+			}
+		}
+	}
+	return found, nil
+}
+
+func (r *fakeRepository) GetStatistics(ctx context.Context, req *models.StatisticsRequest) (*models.StatisticsResponse, error) {
+	r.statisticsRequest = *req
+	response := r.statistics
+	response.Groups = append([]models.AppointmentStatistics(nil), r.statistics.Groups...)
+	return &response, nil
+}
+
+// newTestService returns the undecorated service around repo
+func newTestService(repo repository.AppointmentRepository) *appointmentService {
+	return &appointmentService{
+		repo: repo,
+		scheduling: config.SchedulingConfig{
+			WorkdayStartHour: 9,
+			WorkdayEndHour:   17,
+			HoldTTL:          15 * time.Minute,
+			MaxHoldTTL:       time.Hour,
+			// All-day tests book whole days
+			AllDayExemptFromDurationLimits: true,
+		},
+		subscribers: make(map[string]map[chan AppointmentEvent]access),
+	}
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/pasDamola/schedule-management-system/internal/models"
+	"github.com/sirupsen/logrus"
+)
+
+func (s *appointmentService) GetStatistics(ctx context.Context, req *models.StatisticsRequest) (*models.StatisticsResponse, error) {
+	if err := req.Validate(); err != nil {
+		logrus.WithError(err).Error("Invalid statistics request")
+		return nil, err
+	}
+	if err := authorize(accessFromContext(ctx).canViewAll()); err != nil {
+		return nil, err
+	}
+
+	// Buckets, peak hours and working hours all use one reporting zone
+	if req.TimeZone == "" {
+		req.TimeZone = s.scheduling.WorkdayTimeZone
+	}
+	loc, err := models.LoadTimeZone(req.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+	req.TimeZone = loc.String()
+
+	response, err := s.repo.GetStatistics(ctx, req)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to get appointment statistics")
+		return nil, err
+	}
+
+	// Utilization is measured against working hours, which depend on configuration
+	s.applyUtilization(&response.Summary, req.StartDate, req.EndDate, loc)
+
+	for i := range response.Groups {
+		group := &response.Groups[i]
+		group.PeriodStart, group.PeriodEnd = req.StartDate, req.EndDate
+
+		// Calendar days rather than fixed durations so DST transitions are honoured
+		var days int
+		switch req.GroupBy {
+		case models.GroupByDay:
+			days = 1
+		case models.GroupByWeek:
+			days = 7
+		}
+		if days > 0 {
+			if bucketStart, err := time.ParseInLocation("2006-01-02", group.Key, loc); err == nil {
+				group.PeriodStart = latest(bucketStart, req.StartDate)
+				group.PeriodEnd = earliest(bucketStart.AddDate(0, 0, days), req.EndDate)
+			}
+		}
+
+		s.applyUtilization(group, group.PeriodStart, group.PeriodEnd, loc)
+	}
+
+	return response, nil
+}
+
+func (s *appointmentService) applyUtilization(stats *models.AppointmentStatistics, start, end time.Time, loc *time.Location) {
+	stats.AvailableHours = workingHoursBetween(start, end, loc, s.scheduling.WorkdayStartHour, s.scheduling.WorkdayEndHour)
+	if stats.AvailableHours > 0 {
+		stats.Utilization = stats.BookedHours / stats.AvailableHours
+	}
+}
+
+// workingHoursBetween returns the number of working hours (Monday to Friday,
+// between the workday start and end hour in loc) that fall inside [start, end)
+func workingHoursBetween(start, end time.Time, loc *time.Location, workdayStartHour, workdayEndHour int) float64 {
+	if !start.Before(end) || workdayEndHour <= workdayStartHour {
+		return 0
+	}
+
+	start, end = start.In(loc), end.In(loc)
+	var total time.Duration
+
+	for day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, loc); day.Before(end); day = day.AddDate(0, 0, 1) {
+		if day.Weekday() == time.Saturday || day.Weekday() == time.Sunday {
+			continue
+		}
+
+		workStart := latest(time.Date(day.Year(), day.Month(), day.Day(), workdayStartHour, 0, 0, 0, loc), start)
+		workEnd := earliest(time.Date(day.Year(), day.Month(), day.Day(), workdayEndHour, 0, 0, 0, loc), end)
+		if workEnd.After(workStart) {
+			total += workEnd.Sub(workStart)
+		}
+	}
+
+	return total.Hours()
+}
+
+func latest(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+func earliest(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pasDamola/schedule-management-system/internal/models"
+)
+
+func TestGetStatisticsTimeZone(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		configured  string
+		requested   string
+		groupBy     models.StatisticsGroupBy
+		start, end  time.Time
+		key         string
+		wantZone    string
+		wantStart   time.Time
+		wantEnd     time.Time
+		wantHours   float64
+		wantSummary float64
+	}{
+		{
+			name:        "day in the configured zone",
+			configured:  "America/New_York",
+			groupBy:     models.GroupByDay,
+			start:       time.Date(2025, 3, 3, 0, 0, 0, 0, newYork),
+			end:         time.Date(2025, 3, 10, 0, 0, 0, 0, newYork),
+			key:         "2025-03-04",
+			wantZone:    "America/New_York",
+			wantStart:   time.Date(2025, 3, 4, 0, 0, 0, 0, newYork),
+			wantEnd:     time.Date(2025, 3, 5, 0, 0, 0, 0, newYork),
+			wantHours:   8,
+			wantSummary: 40,
+		},
+		{
+			// Weeks are counted in calendar days, so the one with the DST change still ends at midnight
+			name:        "week across a DST change",
+			configured:  "America/New_York",
+			groupBy:     models.GroupByWeek,
+			start:       time.Date(2025, 3, 3, 0, 0, 0, 0, newYork),
+			end:         time.Date(2025, 3, 17, 0, 0, 0, 0, newYork),
+			key:         "2025-03-03",
+			wantZone:    "America/New_York",
+			wantStart:   time.Date(2025, 3, 3, 0, 0, 0, 0, newYork),
+			wantEnd:     time.Date(2025, 3, 10, 0, 0, 0, 0, newYork),
+			wantHours:   40,
+			wantSummary: 80,
+		},
+		{
+			name:        "requested zone wins",
+			configured:  "America/New_York",
+			requested:   "Europe/Berlin",
+			groupBy:     models.GroupByDay,
+			start:       time.Date(2025, 3, 3, 0, 0, 0, 0, berlin),
+			end:         time.Date(2025, 3, 4, 0, 0, 0, 0, berlin),
+			key:         "2025-03-03",
+			wantZone:    "Europe/Berlin",
+			wantStart:   time.Date(2025, 3, 3, 0, 0, 0, 0, berlin),
+			wantEnd:     time.Date(2025, 3, 4, 0, 0, 0, 0, berlin),
+			wantHours:   8,
+			wantSummary: 8,
+		},
+		{
+			name:        "no zone configured",
+			groupBy:     models.GroupByDay,
+			start:       time.Date(2025, 3, 8, 0, 0, 0, 0, time.UTC),
+			end:         time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC),
+			key:         "2025-03-10",
+			wantZone:    "UTC",
+			wantStart:   time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC),
+			wantEnd:     time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC),
+			wantHours:   3,
+			wantSummary: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeRepository()
+			repo.statistics.Groups = []models.AppointmentStatistics{{Key: tt.key}}
+			s := newTestService(repo)
+			s.scheduling.WorkdayTimeZone = tt.configured
+
+			response, err := s.GetStatistics(context.Background(), &models.StatisticsRequest{
+				StartDate: tt.start,
+				EndDate:   tt.end,
+				GroupBy:   tt.groupBy,
+				TimeZone:  tt.requested,
+			})
+			if err != nil {
+				t.Fatalf("GetStatistics() error = %v", err)
+			}
+
+			if repo.statisticsRequest.TimeZone != tt.wantZone {
+				t.Errorf("repository zone = %q, want %q", repo.statisticsRequest.TimeZone, tt.wantZone)
+			}
+			group := response.Groups[0]
+			if !group.PeriodStart.Equal(tt.wantStart) || !group.PeriodEnd.Equal(tt.wantEnd) {
+				t.Errorf("period = %v to %v, want %v to %v", group.PeriodStart, group.PeriodEnd, tt.wantStart, tt.wantEnd)
+			}
+			if group.AvailableHours != tt.wantHours {
+				t.Errorf("group available hours = %v, want %v", group.AvailableHours, tt.wantHours)
+			}
+			if response.Summary.AvailableHours != tt.wantSummary {
+				t.Errorf("summary available hours = %v, want %v", response.Summary.AvailableHours, tt.wantSummary)
+			}
+		})
+	}
+}
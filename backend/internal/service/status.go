@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pasDamola/schedule-management-system/internal/models"
+	"github.com/sirupsen/logrus"
+)
+
+func (s *appointmentService) UpdateAppointmentStatus(ctx context.Context, req *models.UpdateStatusRequest) (*models.Appointment, error) {
+	if err := req.Validate(); err != nil {
+		logrus.WithError(err).Error("Invalid update appointment status request")
+		return nil, err
+	}
+
+	current, err := s.repo.GetByID(ctx, req.ID)
+	if err != nil {
+		logrus.WithError(err).WithField("appointment_id", req.ID).Error("Failed to get appointment for status update")
+		return nil, err
+	}
+	if err := authorize(accessFromContext(ctx).canEdit(current)); err != nil {
+		return nil, err
+	}
+
+	if !current.Status.CanTransitionTo(req.Status) {
+		logrus.WithFields(logrus.Fields{
+			"appointment_id": req.ID,
+			"from":           current.Status,
+			"to":             req.Status,
+		}).Warn("Rejected appointment status transition")
+		return nil, models.ErrInvalidStatusTransition
+	}
+
+	appointment, err := s.repo.UpdateStatus(ctx, req.ID, current.Status, req.Status, req.Reason)
+	if err != nil {
+		logrus.WithError(err).WithField("appointment_id", req.ID).Error("Failed to update appointment status")
+		return nil, err
+	}
+
+	s.notifySubscribers(AppointmentEvent{
+		Type:        EventTypeUpdated,
+		Appointment: appointment,
+		Timestamp:   time.Now(),
+	})
+
+	return appointment, nil
+}
+
+func (s *appointmentService) CancelAppointment(ctx context.Context, id uuid.UUID, reason string) (*models.Appointment, error) {
+	return s.UpdateAppointmentStatus(ctx, &models.UpdateStatusRequest{
+		ID:     id,
+		Status: models.StatusCancelled,
+		Reason: reason,
+	})
+}
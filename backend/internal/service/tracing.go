@@ -0,0 +1,266 @@
+package service
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pasDamola/schedule-management-system/internal/auth"
+	"github.com/pasDamola/schedule-management-system/internal/models"
+	"github.com/pasDamola/schedule-management-system/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const tracerScope = "github.com/pasDamola/schedule-management-system/internal/service"
+
+// traceCall runs fn in a span named after the AppointmentService method
+func traceCall[T any](ctx context.Context, method string, fn func(context.Context) (T, error), attrs ...attribute.KeyValue) (T, error) {
+	ctx, span := tracing.Start(ctx, tracerScope, "AppointmentService."+method, attrs...)
+	result, err := fn(ctx)
+	tracing.End(span, err)
+	return result, err
+}
+
+// traceErr is traceCall for methods that only return an error
+func traceErr(ctx context.Context, method string, fn func(context.Context) error, attrs ...attribute.KeyValue) error {
+	_, err := traceCall(ctx, method, func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, fn(ctx)
+	}, attrs...)
+	return err
+}
+
+func appointmentID(id uuid.UUID) attribute.KeyValue {
+	return attribute.String("appointment.id", id.String())
+}
+
+func calendarID(id string) attribute.KeyValue {
+	return attribute.String("appointment.calendar_id", id)
+}
+
+// tracedService records a span for every AppointmentService call. The
+// background loops and subscriptions are passed through, since they are not
+// requests; the repository calls they make are traced on their own.
+type tracedService struct {
+	next AppointmentService
+}
+
+func (t *tracedService) CreateAppointment(ctx context.Context, req *models.CreateAppointmentRequest) (*models.Appointment, error) {
+	return traceCall(ctx, "CreateAppointment", func(ctx context.Context) (*models.Appointment, error) {
+		return t.next.CreateAppointment(ctx, req)
+	}, calendarID(req.CalendarID))
+}
+
+func (t *tracedService) GetAppointment(ctx context.Context, id uuid.UUID) (*models.Appointment, error) {
+	return traceCall(ctx, "GetAppointment", func(ctx context.Context) (*models.Appointment, error) {
+		return t.next.GetAppointment(ctx, id)
+	}, appointmentID(id))
+}
+
+func (t *tracedService) DeleteAppointment(ctx context.Context, id uuid.UUID) error {
+	return traceErr(ctx, "DeleteAppointment", func(ctx context.Context) error {
+		return t.next.DeleteAppointment(ctx, id)
+	}, appointmentID(id))
+}
+
+func (t *tracedService) ReplaceAppointment(ctx context.Context, id uuid.UUID, req *models.CreateAppointmentRequest) (*models.Appointment, error) {
+	return traceCall(ctx, "ReplaceAppointment", func(ctx context.Context) (*models.Appointment, error) {
+		return t.next.ReplaceAppointment(ctx, id, req)
+	}, appointmentID(id))
+}
+
+func (t *tracedService) GetAppointmentByICalUID(ctx context.Context, calendar, uid string) (*models.Appointment, error) {
+	return traceCall(ctx, "GetAppointmentByICalUID", func(ctx context.Context) (*models.Appointment, error) {
+		return t.next.GetAppointmentByICalUID(ctx, calendar, uid)
+	}, calendarID(calendar))
+}
+
+func (t *tracedService) BatchCreateAppointments(ctx context.Context, reqs []*models.CreateAppointmentRequest, mode models.BatchMode) ([]models.BatchItemResult, error) {
+	return traceCall(ctx, "BatchCreateAppointments", func(ctx context.Context) ([]models.BatchItemResult, error) {
+		return t.next.BatchCreateAppointments(ctx, reqs, mode)
+	}, attribute.Int("batch.size", len(reqs)))
+}
+
+func (t *tracedService) BatchDeleteAppointments(ctx context.Context, ids []uuid.UUID, mode models.BatchMode) ([]models.BatchItemResult, error) {
+	return traceCall(ctx, "BatchDeleteAppointments", func(ctx context.Context) ([]models.BatchItemResult, error) {
+		return t.next.BatchDeleteAppointments(ctx, ids, mode)
+	}, attribute.Int("batch.size", len(ids)))
+}
+
+func (t *tracedService) ListAppointments(ctx context.Context, req *models.ListAppointmentsRequest) (*models.ListAppointmentsResponse, error) {
+	return traceCall(ctx, "ListAppointments", func(ctx context.Context) (*models.ListAppointmentsResponse, error) {
+		return t.next.ListAppointments(ctx, req)
+	})
+}
+
+func (t *tracedService) ImportICS(ctx context.Context, data io.Reader, opts *models.ImportOptions) (*models.ImportReport, error) {
+	return traceCall(ctx, "ImportICS", func(ctx context.Context) (*models.ImportReport, error) {
+		return t.next.ImportICS(ctx, data, opts)
+	})
+}
+
+func (t *tracedService) ImportRecords(ctx context.Context, data io.Reader, opts *models.RecordImportOptions) (*models.RecordImportReport, error) {
+	return traceCall(ctx, "ImportRecords", func(ctx context.Context) (*models.RecordImportReport, error) {
+		return t.next.ImportRecords(ctx, data, opts)
+	})
+}
+
+func (t *tracedService) ExportAppointments(ctx context.Context, req *models.ListAppointmentsRequest) ([]models.Appointment, error) {
+	return traceCall(ctx, "ExportAppointments", func(ctx context.Context) ([]models.Appointment, error) {
+		return t.next.ExportAppointments(ctx, req)
+	})
+}
+
+func (t *tracedService) ListCalendarAppointments(ctx context.Context, calendarID string, since time.Time) ([]models.Appointment, error) {
+	return traceCall(ctx, "ListCalendarAppointments", func(ctx context.Context) ([]models.Appointment, error) {
+		return t.next.ListCalendarAppointments(ctx, calendarID, since)
+	})
+}
+
+func (t *tracedService) CreateFeedToken(ctx context.Context, calendar string) (*models.FeedToken, error) {
+	return traceCall(ctx, "CreateFeedToken", func(ctx context.Context) (*models.FeedToken, error) {
+		return t.next.CreateFeedToken(ctx, calendar)
+	}, calendarID(calendar))
+}
+
+func (t *tracedService) RotateFeedToken(ctx context.Context, id uuid.UUID) (*models.FeedToken, error) {
+	return traceCall(ctx, "RotateFeedToken", func(ctx context.Context) (*models.FeedToken, error) {
+		return t.next.RotateFeedToken(ctx, id)
+	})
+}
+
+func (t *tracedService) RevokeFeedToken(ctx context.Context, id uuid.UUID) error {
+	return traceErr(ctx, "RevokeFeedToken", func(ctx context.Context) error {
+		return t.next.RevokeFeedToken(ctx, id)
+	})
+}
+
+func (t *tracedService) ListFeedTokens(ctx context.Context, calendar string) ([]models.FeedToken, error) {
+	return traceCall(ctx, "ListFeedTokens", func(ctx context.Context) ([]models.FeedToken, error) {
+		return t.next.ListFeedTokens(ctx, calendar)
+	}, calendarID(calendar))
+}
+
+func (t *tracedService) GetFeed(ctx context.Context, token string) (*models.Feed, error) {
+	return traceCall(ctx, "GetFeed", func(ctx context.Context) (*models.Feed, error) {
+		return t.next.GetFeed(ctx, token)
+	})
+}
+
+func (t *tracedService) GetStatistics(ctx context.Context, req *models.StatisticsRequest) (*models.StatisticsResponse, error) {
+	return traceCall(ctx, "GetStatistics", func(ctx context.Context) (*models.StatisticsResponse, error) {
+		return t.next.GetStatistics(ctx, req)
+	})
+}
+
+func (t *tracedService) GetCalendarSettings(ctx context.Context, calendar string) (*models.CalendarSettings, error) {
+	return traceCall(ctx, "GetCalendarSettings", func(ctx context.Context) (*models.CalendarSettings, error) {
+		return t.next.GetCalendarSettings(ctx, calendar)
+	}, calendarID(calendar))
+}
+
+func (t *tracedService) UpdateCalendarSettings(ctx context.Context, req *models.UpdateCalendarSettingsRequest) (*models.CalendarSettings, error) {
+	return traceCall(ctx, "UpdateCalendarSettings", func(ctx context.Context) (*models.CalendarSettings, error) {
+		return t.next.UpdateCalendarSettings(ctx, req)
+	}, calendarID(req.CalendarID))
+}
+
+func (t *tracedService) ListCalendars(ctx context.Context) ([]string, error) {
+	return traceCall(ctx, "ListCalendars", func(ctx context.Context) ([]string, error) {
+		return t.next.ListCalendars(ctx)
+	})
+}
+
+func (t *tracedService) HoldSlot(ctx context.Context, req *models.HoldSlotRequest) (*models.Appointment, error) {
+	return traceCall(ctx, "HoldSlot", func(ctx context.Context) (*models.Appointment, error) {
+		return t.next.HoldSlot(ctx, req)
+	})
+}
+
+func (t *tracedService) ConfirmHold(ctx context.Context, req *models.ConfirmHoldRequest) (*models.Appointment, error) {
+	return traceCall(ctx, "ConfirmHold", func(ctx context.Context) (*models.Appointment, error) {
+		return t.next.ConfirmHold(ctx, req)
+	}, appointmentID(req.ID))
+}
+
+func (t *tracedService) RunHoldReaper(ctx context.Context) {
+	t.next.RunHoldReaper(ctx)
+}
+
+func (t *tracedService) UpdateAppointmentStatus(ctx context.Context, req *models.UpdateStatusRequest) (*models.Appointment, error) {
+	return traceCall(ctx, "UpdateAppointmentStatus", func(ctx context.Context) (*models.Appointment, error) {
+		return t.next.UpdateAppointmentStatus(ctx, req)
+	}, appointmentID(req.ID))
+}
+
+func (t *tracedService) CancelAppointment(ctx context.Context, id uuid.UUID, reason string) (*models.Appointment, error) {
+	return traceCall(ctx, "CancelAppointment", func(ctx context.Context) (*models.Appointment, error) {
+		return t.next.CancelAppointment(ctx, id, reason)
+	}, appointmentID(id))
+}
+
+func (t *tracedService) RestoreAppointment(ctx context.Context, id uuid.UUID) (*models.Appointment, error) {
+	return traceCall(ctx, "RestoreAppointment", func(ctx context.Context) (*models.Appointment, error) {
+		return t.next.RestoreAppointment(ctx, id)
+	}, appointmentID(id))
+}
+
+func (t *tracedService) ListDeletedAppointments(ctx context.Context, req *models.ListAppointmentsRequest) (*models.ListAppointmentsResponse, error) {
+	return traceCall(ctx, "ListDeletedAppointments", func(ctx context.Context) (*models.ListAppointmentsResponse, error) {
+		return t.next.ListDeletedAppointments(ctx, req)
+	})
+}
+
+func (t *tracedService) PurgeAppointment(ctx context.Context, id uuid.UUID) error {
+	return traceErr(ctx, "PurgeAppointment", func(ctx context.Context) error {
+		return t.next.PurgeAppointment(ctx, id)
+	}, appointmentID(id))
+}
+
+func (t *tracedService) RunTrashPurger(ctx context.Context) {
+	t.next.RunTrashPurger(ctx)
+}
+
+func (t *tracedService) GetAppointmentHistory(ctx context.Context, id uuid.UUID) ([]models.AuditEvent, error) {
+	return traceCall(ctx, "GetAppointmentHistory", func(ctx context.Context) ([]models.AuditEvent, error) {
+		return t.next.GetAppointmentHistory(ctx, id)
+	}, appointmentID(id))
+}
+
+func (t *tracedService) ListAuditEvents(ctx context.Context, req *models.ListAuditEventsRequest) (*models.ListAuditEventsResponse, error) {
+	return traceCall(ctx, "ListAuditEvents", func(ctx context.Context) (*models.ListAuditEventsResponse, error) {
+		return t.next.ListAuditEvents(ctx, req)
+	})
+}
+
+func (t *tracedService) CreateAPIKey(ctx context.Context, req *models.CreateAPIKeyRequest) (*models.APIKey, error) {
+	return traceCall(ctx, "CreateAPIKey", func(ctx context.Context) (*models.APIKey, error) {
+		return t.next.CreateAPIKey(ctx, req)
+	})
+}
+
+func (t *tracedService) ListAPIKeys(ctx context.Context) ([]models.APIKey, error) {
+	return traceCall(ctx, "ListAPIKeys", func(ctx context.Context) ([]models.APIKey, error) {
+		return t.next.ListAPIKeys(ctx)
+	})
+}
+
+func (t *tracedService) RevokeAPIKey(ctx context.Context, id uuid.UUID) error {
+	return traceErr(ctx, "RevokeAPIKey", func(ctx context.Context) error {
+		return t.next.RevokeAPIKey(ctx, id)
+	})
+}
+
+func (t *tracedService) AuthenticateAPIKey(ctx context.Context, key string) (*auth.Principal, error) {
+	return traceCall(ctx, "AuthenticateAPIKey", func(ctx context.Context) (*auth.Principal, error) {
+		return t.next.AuthenticateAPIKey(ctx, key)
+	})
+}
+
+func (t *tracedService) SubscribeToUpdates(ctx context.Context) chan AppointmentEvent {
+	return t.next.SubscribeToUpdates(ctx)
+}
+
+func (t *tracedService) UnsubscribeFromUpdates(ch chan AppointmentEvent) {
+	t.next.UnsubscribeFromUpdates(ch)
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pasDamola/schedule-management-system/internal/models"
+	"github.com/pasDamola/schedule-management-system/internal/requestinfo"
+	"github.com/sirupsen/logrus"
+)
+
+func (s *appointmentService) RestoreAppointment(ctx context.Context, id uuid.UUID) (*models.Appointment, error) {
+	if id == uuid.Nil {
+		return nil, models.ErrInvalidID
+	}
+	if err := s.authorizeDeleted(ctx, id); err != nil {
+		return nil, err
+	}
+
+	appointment, err := s.repo.Restore(ctx, id)
+	if err != nil {
+		logrus.WithError(err).WithField("appointment_id", id).Error("Failed to restore appointment")
+		return nil, err
+	}
+
+	// Restored appointments reappear for subscribers as if newly created
+	s.notifySubscribers(AppointmentEvent{
+		Type:        EventTypeCreated,
+		Appointment: appointment,
+		Timestamp:   time.Now(),
+	})
+
+	logrus.WithField("appointment_id", id).Info("Appointment restored successfully")
+	return appointment, nil
+}
+
+func (s *appointmentService) ListDeletedAppointments(ctx context.Context, req *models.ListAppointmentsRequest) (*models.ListAppointmentsResponse, error) {
+	req.Deleted = true
+	return s.ListAppointments(ctx, req)
+}
+
+func (s *appointmentService) PurgeAppointment(ctx context.Context, id uuid.UUID) error {
+	if id == uuid.Nil {
+		return models.ErrInvalidID
+	}
+	if err := s.authorizeDeleted(ctx, id); err != nil {
+		return err
+	}
+
+	if err := s.repo.Purge(ctx, id); err != nil {
+		logrus.WithError(err).WithField("appointment_id", id).Error("Failed to purge appointment")
+		return err
+	}
+
+	logrus.WithField("appointment_id", id).Info("Appointment purged successfully")
+	return nil
+}
+
+// authorizeDeleted checks the caller may edit an appointment in the trash
+func (s *appointmentService) authorizeDeleted(ctx context.Context, id uuid.UUID) error {
+	if accessFromContext(ctx).unrestricted() {
+		return nil
+	}
+	appointment, err := s.repo.GetDeletedByID(ctx, id)
+	if err != nil {
+		logrus.WithError(err).WithField("appointment_id", id).Error("Failed to get deleted appointment")
+		return err
+	}
+	return authorize(accessFromContext(ctx).canEdit(appointment))
+}
+
+// RunTrashPurger permanently removes trash older than TrashRetention every
+// TrashPurgeInterval until ctx is cancelled
+func (s *appointmentService) RunTrashPurger(ctx context.Context) {
+	ctx = requestinfo.WithSystemActor(ctx)
+	ticker := time.NewTicker(s.scheduling.TrashPurgeInterval)
+	defer ticker.Stop()
+
+	logrus.WithFields(logrus.Fields{
+		"interval":  s.scheduling.TrashPurgeInterval,
+		"retention": s.scheduling.TrashRetention,
+	}).Info("Trash purger started")
+
+	for {
+		select {
+		case <-ticker.C:
+			purged, err := s.repo.PurgeDeletedBefore(ctx, time.Now().Add(-s.scheduling.TrashRetention))
+			if err != nil {
+				logrus.WithError(err).Error("Failed to purge trash")
+				continue
+			}
+			if purged > 0 {
+				logrus.WithField("count", purged).Info("Trash purged")
+			}
+		case <-ctx.Done():
+			logrus.Info("Trash purger stopped")
+			return
+		}
+	}
+}
@@ -7,8 +7,10 @@
 package pb
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
@@ -23,6 +25,159 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Appointment lifecycle. Cancelled, completed and no-show are terminal.
+type AppointmentStatus int32
+
+const (
+	AppointmentStatus_SCHEDULED AppointmentStatus = 0
+	AppointmentStatus_CONFIRMED AppointmentStatus = 1
+	AppointmentStatus_CANCELLED AppointmentStatus = 2
+	AppointmentStatus_COMPLETED AppointmentStatus = 3
+	AppointmentStatus_NO_SHOW   AppointmentStatus = 4
+)
+
+// Enum value maps for AppointmentStatus.
+var (
+	AppointmentStatus_name = map[int32]string{
+		0: "SCHEDULED",
+		1: "CONFIRMED",
+		2: "CANCELLED",
+		3: "COMPLETED",
+		4: "NO_SHOW",
+	}
+	AppointmentStatus_value = map[string]int32{
+		"SCHEDULED": 0,
+		"CONFIRMED": 1,
+		"CANCELLED": 2,
+		"COMPLETED": 3,
+		"NO_SHOW":   4,
+	}
+)
+
+func (x AppointmentStatus) Enum() *AppointmentStatus {
+	p := new(AppointmentStatus)
+	*p = x
+	return p
+}
+
+func (x AppointmentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AppointmentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_appointment_appointment_proto_enumTypes[0].Descriptor()
+}
+
+func (AppointmentStatus) Type() protoreflect.EnumType {
+	return &file_proto_appointment_appointment_proto_enumTypes[0]
+}
+
+func (x AppointmentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AppointmentStatus.Descriptor instead.
+func (AppointmentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{0}
+}
+
+// How a batch handles failing items
+type BatchMode int32
+
+const (
+	// All items succeed in one transaction or the whole batch fails
+	BatchMode_ATOMIC BatchMode = 0
+	// Items are applied independently and failures are reported per item
+	BatchMode_BEST_EFFORT BatchMode = 1
+)
+
+// Enum value maps for BatchMode.
+var (
+	BatchMode_name = map[int32]string{
+		0: "ATOMIC",
+		1: "BEST_EFFORT",
+	}
+	BatchMode_value = map[string]int32{
+		"ATOMIC":      0,
+		"BEST_EFFORT": 1,
+	}
+)
+
+func (x BatchMode) Enum() *BatchMode {
+	p := new(BatchMode)
+	*p = x
+	return p
+}
+
+func (x BatchMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BatchMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_appointment_appointment_proto_enumTypes[1].Descriptor()
+}
+
+func (BatchMode) Type() protoreflect.EnumType {
+	return &file_proto_appointment_appointment_proto_enumTypes[1]
+}
+
+func (x BatchMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BatchMode.Descriptor instead.
+func (BatchMode) EnumDescriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{1}
+}
+
+// Spreadsheet-friendly file formats with one appointment per row
+type RecordFormat int32
+
+const (
+	RecordFormat_CSV RecordFormat = 0
+	// Newline-delimited JSON, one object per line
+	RecordFormat_NDJSON RecordFormat = 1
+)
+
+// Enum value maps for RecordFormat.
+var (
+	RecordFormat_name = map[int32]string{
+		0: "CSV",
+		1: "NDJSON",
+	}
+	RecordFormat_value = map[string]int32{
+		"CSV":    0,
+		"NDJSON": 1,
+	}
+)
+
+func (x RecordFormat) Enum() *RecordFormat {
+	p := new(RecordFormat)
+	*p = x
+	return p
+}
+
+func (x RecordFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RecordFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_appointment_appointment_proto_enumTypes[2].Descriptor()
+}
+
+func (RecordFormat) Type() protoreflect.EnumType {
+	return &file_proto_appointment_appointment_proto_enumTypes[2]
+}
+
+func (x RecordFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RecordFormat.Descriptor instead.
+func (RecordFormat) EnumDescriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{2}
+}
+
 type AppointmentStreamResponse_EventType int32
 
 const (
@@ -56,11 +211,11 @@ func (x AppointmentStreamResponse_EventType) String() string {
 }
 
 func (AppointmentStreamResponse_EventType) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_appointment_appointment_proto_enumTypes[0].Descriptor()
+	return file_proto_appointment_appointment_proto_enumTypes[3].Descriptor()
 }
 
 func (AppointmentStreamResponse_EventType) Type() protoreflect.EnumType {
-	return &file_proto_appointment_appointment_proto_enumTypes[0]
+	return &file_proto_appointment_appointment_proto_enumTypes[3]
 }
 
 func (x AppointmentStreamResponse_EventType) Number() protoreflect.EnumNumber {
@@ -69,18 +224,145 @@ func (x AppointmentStreamResponse_EventType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AppointmentStreamResponse_EventType.Descriptor instead.
 func (AppointmentStreamResponse_EventType) EnumDescriptor() ([]byte, []int) {
-	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{7, 0}
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{13, 0}
+}
+
+type GetStatisticsRequest_GroupBy int32
+
+const (
+	GetStatisticsRequest_NONE     GetStatisticsRequest_GroupBy = 0
+	GetStatisticsRequest_DAY      GetStatisticsRequest_GroupBy = 1
+	GetStatisticsRequest_WEEK     GetStatisticsRequest_GroupBy = 2
+	GetStatisticsRequest_CALENDAR GetStatisticsRequest_GroupBy = 3
+)
+
+// Enum value maps for GetStatisticsRequest_GroupBy.
+var (
+	GetStatisticsRequest_GroupBy_name = map[int32]string{
+		0: "NONE",
+		1: "DAY",
+		2: "WEEK",
+		3: "CALENDAR",
+	}
+	GetStatisticsRequest_GroupBy_value = map[string]int32{
+		"NONE":     0,
+		"DAY":      1,
+		"WEEK":     2,
+		"CALENDAR": 3,
+	}
+)
+
+func (x GetStatisticsRequest_GroupBy) Enum() *GetStatisticsRequest_GroupBy {
+	p := new(GetStatisticsRequest_GroupBy)
+	*p = x
+	return p
+}
+
+func (x GetStatisticsRequest_GroupBy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetStatisticsRequest_GroupBy) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_appointment_appointment_proto_enumTypes[4].Descriptor()
+}
+
+func (GetStatisticsRequest_GroupBy) Type() protoreflect.EnumType {
+	return &file_proto_appointment_appointment_proto_enumTypes[4]
+}
+
+func (x GetStatisticsRequest_GroupBy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetStatisticsRequest_GroupBy.Descriptor instead.
+func (GetStatisticsRequest_GroupBy) EnumDescriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{17, 0}
+}
+
+type ImportedEvent_Outcome int32
+
+const (
+	ImportedEvent_IMPORTED ImportedEvent_Outcome = 0
+	// The UID was imported before or is the ID of an existing appointment
+	ImportedEvent_DUPLICATE ImportedEvent_Outcome = 1
+	// The slot is taken by an existing appointment or an earlier event in the file
+	ImportedEvent_CONFLICT ImportedEvent_Outcome = 2
+	// The event is invalid or unsupported; see error
+	ImportedEvent_FAILED ImportedEvent_Outcome = 3
+)
+
+// Enum value maps for ImportedEvent_Outcome.
+var (
+	ImportedEvent_Outcome_name = map[int32]string{
+		0: "IMPORTED",
+		1: "DUPLICATE",
+		2: "CONFLICT",
+		3: "FAILED",
+	}
+	ImportedEvent_Outcome_value = map[string]int32{
+		"IMPORTED":  0,
+		"DUPLICATE": 1,
+		"CONFLICT":  2,
+		"FAILED":    3,
+	}
+)
+
+func (x ImportedEvent_Outcome) Enum() *ImportedEvent_Outcome {
+	p := new(ImportedEvent_Outcome)
+	*p = x
+	return p
+}
+
+func (x ImportedEvent_Outcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportedEvent_Outcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_appointment_appointment_proto_enumTypes[5].Descriptor()
+}
+
+func (ImportedEvent_Outcome) Type() protoreflect.EnumType {
+	return &file_proto_appointment_appointment_proto_enumTypes[5]
+}
+
+func (x ImportedEvent_Outcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportedEvent_Outcome.Descriptor instead.
+func (ImportedEvent_Outcome) EnumDescriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{34, 0}
 }
 
 // Appointment message definition
 type Appointment struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	StartTime     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title      string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	StartTime  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CalendarId string                 `protobuf:"bytes,7,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`
+	// IANA time zone of the organizer, e.g. "Europe/Berlin"
+	TimeZone string `protobuf:"bytes,8,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// All-day events span start_date to end_date (inclusive, YYYY-MM-DD) in time_zone
+	AllDay    bool   `protobuf:"varint,9,opt,name=all_day,json=allDay,proto3" json:"all_day,omitempty"`
+	StartDate string `protobuf:"bytes,10,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate   string `protobuf:"bytes,11,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// Set while the appointment is a tentative hold
+	HoldExpiresAt      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=hold_expires_at,json=holdExpiresAt,proto3" json:"hold_expires_at,omitempty"`
+	Status             AppointmentStatus      `protobuf:"varint,13,opt,name=status,proto3,enum=appointment.AppointmentStatus" json:"status,omitempty"`
+	CancellationReason string                 `protobuf:"bytes,14,opt,name=cancellation_reason,json=cancellationReason,proto3" json:"cancellation_reason,omitempty"`
+	CancelledAt        *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=cancelled_at,json=cancelledAt,proto3" json:"cancelled_at,omitempty"`
+	// Set while the appointment is in the trash
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	// UID of the iCalendar event the appointment was imported from
+	IcalUid string `protobuf:"bytes,17,opt,name=ical_uid,json=icalUid,proto3" json:"ical_uid,omitempty"`
+	// Caller who may manage the appointment besides the calendar's editors
+	Owner string `protobuf:"bytes,18,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Caller who created the appointment
+	CreatedBy     string `protobuf:"bytes,19,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -157,12 +439,112 @@ func (x *Appointment) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Appointment) GetCalendarId() string {
+	if x != nil {
+		return x.CalendarId
+	}
+	return ""
+}
+
+func (x *Appointment) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *Appointment) GetAllDay() bool {
+	if x != nil {
+		return x.AllDay
+	}
+	return false
+}
+
+func (x *Appointment) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *Appointment) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *Appointment) GetHoldExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.HoldExpiresAt
+	}
+	return nil
+}
+
+func (x *Appointment) GetStatus() AppointmentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AppointmentStatus_SCHEDULED
+}
+
+func (x *Appointment) GetCancellationReason() string {
+	if x != nil {
+		return x.CancellationReason
+	}
+	return ""
+}
+
+func (x *Appointment) GetCancelledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CancelledAt
+	}
+	return nil
+}
+
+func (x *Appointment) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Appointment) GetIcalUid() string {
+	if x != nil {
+		return x.IcalUid
+	}
+	return ""
+}
+
+func (x *Appointment) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *Appointment) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
 // Request messages
 type CreateAppointmentRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	StartTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Title     string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Defaults to "default" when empty
+	CalendarId string `protobuf:"bytes,4,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`
+	// IANA time zone of the organizer; defaults to "UTC" when empty
+	TimeZone string `protobuf:"bytes,5,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// All-day events use start_date and end_date (inclusive, YYYY-MM-DD)
+	// in time_zone instead of start_time and end_time
+	AllDay        bool   `protobuf:"varint,6,opt,name=all_day,json=allDay,proto3" json:"all_day,omitempty"`
+	StartDate     string `protobuf:"bytes,7,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       string `protobuf:"bytes,8,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -192,54 +574,2998 @@ func (x *CreateAppointmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateAppointmentRequest.ProtoReflect.Descriptor instead.
-func (*CreateAppointmentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use CreateAppointmentRequest.ProtoReflect.Descriptor instead.
+func (*CreateAppointmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateAppointmentRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateAppointmentRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *CreateAppointmentRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *CreateAppointmentRequest) GetCalendarId() string {
+	if x != nil {
+		return x.CalendarId
+	}
+	return ""
+}
+
+func (x *CreateAppointmentRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *CreateAppointmentRequest) GetAllDay() bool {
+	if x != nil {
+		return x.AllDay
+	}
+	return false
+}
+
+func (x *CreateAppointmentRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *CreateAppointmentRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+type HoldSlotRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Defaults to "Hold" when empty
+	Title      string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	StartTime  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	CalendarId string                 `protobuf:"bytes,4,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`
+	TimeZone   string                 `protobuf:"bytes,5,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// How long the hold lasts; defaults to the server's HOLD_TTL
+	Ttl           *durationpb.Duration `protobuf:"bytes,6,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HoldSlotRequest) Reset() {
+	*x = HoldSlotRequest{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HoldSlotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HoldSlotRequest) ProtoMessage() {}
+
+func (x *HoldSlotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HoldSlotRequest.ProtoReflect.Descriptor instead.
+func (*HoldSlotRequest) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *HoldSlotRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *HoldSlotRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *HoldSlotRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *HoldSlotRequest) GetCalendarId() string {
+	if x != nil {
+		return x.CalendarId
+	}
+	return ""
+}
+
+func (x *HoldSlotRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *HoldSlotRequest) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+type ConfirmHoldRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Replaces the hold's title when set
+	Title         string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmHoldRequest) Reset() {
+	*x = ConfirmHoldRequest{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmHoldRequest) ProtoMessage() {}
+
+func (x *ConfirmHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmHoldRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmHoldRequest) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ConfirmHoldRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ConfirmHoldRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type GetAppointmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAppointmentRequest) Reset() {
+	*x = GetAppointmentRequest{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAppointmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAppointmentRequest) ProtoMessage() {}
+
+func (x *GetAppointmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAppointmentRequest.ProtoReflect.Descriptor instead.
+func (*GetAppointmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetAppointmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Moves the appointment to the trash, from where it can be restored or purged
+type DeleteAppointmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAppointmentRequest) Reset() {
+	*x = DeleteAppointmentRequest{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAppointmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAppointmentRequest) ProtoMessage() {}
+
+func (x *DeleteAppointmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAppointmentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAppointmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteAppointmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RestoreAppointmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreAppointmentRequest) Reset() {
+	*x = RestoreAppointmentRequest{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreAppointmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreAppointmentRequest) ProtoMessage() {}
+
+func (x *RestoreAppointmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreAppointmentRequest.ProtoReflect.Descriptor instead.
+func (*RestoreAppointmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RestoreAppointmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListDeletedAppointmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedAppointmentsRequest) Reset() {
+	*x = ListDeletedAppointmentsRequest{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedAppointmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedAppointmentsRequest) ProtoMessage() {}
+
+func (x *ListDeletedAppointmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedAppointmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedAppointmentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListDeletedAppointmentsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListDeletedAppointmentsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// Permanently deletes an appointment that is already in the trash
+type PurgeAppointmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeAppointmentRequest) Reset() {
+	*x = PurgeAppointmentRequest{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeAppointmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeAppointmentRequest) ProtoMessage() {}
+
+func (x *PurgeAppointmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeAppointmentRequest.ProtoReflect.Descriptor instead.
+func (*PurgeAppointmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PurgeAppointmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CancelAppointmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelAppointmentRequest) Reset() {
+	*x = CancelAppointmentRequest{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelAppointmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelAppointmentRequest) ProtoMessage() {}
+
+func (x *CancelAppointmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelAppointmentRequest.ProtoReflect.Descriptor instead.
+func (*CancelAppointmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CancelAppointmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CancelAppointmentRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type UpdateAppointmentStatusRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status AppointmentStatus      `protobuf:"varint,2,opt,name=status,proto3,enum=appointment.AppointmentStatus" json:"status,omitempty"`
+	// Recorded when status is CANCELLED
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAppointmentStatusRequest) Reset() {
+	*x = UpdateAppointmentStatusRequest{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAppointmentStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAppointmentStatusRequest) ProtoMessage() {}
+
+func (x *UpdateAppointmentStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAppointmentStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAppointmentStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateAppointmentStatusRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateAppointmentStatusRequest) GetStatus() AppointmentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AppointmentStatus_SCHEDULED
+}
+
+func (x *UpdateAppointmentStatusRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ListAppointmentsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Page       int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit      int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Search     string                 `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`
+	StartDate  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	CalendarId string                 `protobuf:"bytes,6,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`
+	// Only return appointments in these statuses; empty returns all
+	Statuses      []AppointmentStatus `protobuf:"varint,7,rep,packed,name=statuses,proto3,enum=appointment.AppointmentStatus" json:"statuses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAppointmentsRequest) Reset() {
+	*x = ListAppointmentsRequest{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAppointmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAppointmentsRequest) ProtoMessage() {}
+
+func (x *ListAppointmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAppointmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListAppointmentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListAppointmentsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAppointmentsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListAppointmentsRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *ListAppointmentsRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *ListAppointmentsRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *ListAppointmentsRequest) GetCalendarId() string {
+	if x != nil {
+		return x.CalendarId
+	}
+	return ""
+}
+
+func (x *ListAppointmentsRequest) GetStatuses() []AppointmentStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+type ListAppointmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Appointments  []*Appointment         `protobuf:"bytes,1,rep,name=appointments,proto3" json:"appointments,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAppointmentsResponse) Reset() {
+	*x = ListAppointmentsResponse{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAppointmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAppointmentsResponse) ProtoMessage() {}
+
+func (x *ListAppointmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAppointmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListAppointmentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListAppointmentsResponse) GetAppointments() []*Appointment {
+	if x != nil {
+		return x.Appointments
+	}
+	return nil
+}
+
+func (x *ListAppointmentsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListAppointmentsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAppointmentsResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type AppointmentStreamResponse struct {
+	state         protoimpl.MessageState              `protogen:"open.v1"`
+	EventType     AppointmentStreamResponse_EventType `protobuf:"varint,1,opt,name=event_type,json=eventType,proto3,enum=appointment.AppointmentStreamResponse_EventType" json:"event_type,omitempty"`
+	Appointment   *Appointment                        `protobuf:"bytes,2,opt,name=appointment,proto3" json:"appointment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppointmentStreamResponse) Reset() {
+	*x = AppointmentStreamResponse{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppointmentStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppointmentStreamResponse) ProtoMessage() {}
+
+func (x *AppointmentStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppointmentStreamResponse.ProtoReflect.Descriptor instead.
+func (*AppointmentStreamResponse) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AppointmentStreamResponse) GetEventType() AppointmentStreamResponse_EventType {
+	if x != nil {
+		return x.EventType
+	}
+	return AppointmentStreamResponse_CREATED
+}
+
+func (x *AppointmentStreamResponse) GetAppointment() *Appointment {
+	if x != nil {
+		return x.Appointment
+	}
+	return nil
+}
+
+// Calendar settings messages
+type CalendarSettings struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CalendarId string                 `protobuf:"bytes,1,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`
+	// Whether all-day events in this calendar conflict with other appointments
+	AllDayBlocksTime bool                   `protobuf:"varint,2,opt,name=all_day_blocks_time,json=allDayBlocksTime,proto3" json:"all_day_blocks_time,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CalendarSettings) Reset() {
+	*x = CalendarSettings{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarSettings) ProtoMessage() {}
+
+func (x *CalendarSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarSettings.ProtoReflect.Descriptor instead.
+func (*CalendarSettings) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CalendarSettings) GetCalendarId() string {
+	if x != nil {
+		return x.CalendarId
+	}
+	return ""
+}
+
+func (x *CalendarSettings) GetAllDayBlocksTime() bool {
+	if x != nil {
+		return x.AllDayBlocksTime
+	}
+	return false
+}
+
+func (x *CalendarSettings) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetCalendarSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CalendarId    string                 `protobuf:"bytes,1,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCalendarSettingsRequest) Reset() {
+	*x = GetCalendarSettingsRequest{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarSettingsRequest) ProtoMessage() {}
+
+func (x *GetCalendarSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetCalendarSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetCalendarSettingsRequest) GetCalendarId() string {
+	if x != nil {
+		return x.CalendarId
+	}
+	return ""
+}
+
+type UpdateCalendarSettingsRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CalendarId       string                 `protobuf:"bytes,1,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`
+	AllDayBlocksTime bool                   `protobuf:"varint,2,opt,name=all_day_blocks_time,json=allDayBlocksTime,proto3" json:"all_day_blocks_time,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateCalendarSettingsRequest) Reset() {
+	*x = UpdateCalendarSettingsRequest{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCalendarSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCalendarSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateCalendarSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCalendarSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCalendarSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UpdateCalendarSettingsRequest) GetCalendarId() string {
+	if x != nil {
+		return x.CalendarId
+	}
+	return ""
+}
+
+func (x *UpdateCalendarSettingsRequest) GetAllDayBlocksTime() bool {
+	if x != nil {
+		return x.AllDayBlocksTime
+	}
+	return false
+}
+
+// Statistics messages
+type GetStatisticsRequest struct {
+	state     protoimpl.MessageState       `protogen:"open.v1"`
+	StartDate *timestamppb.Timestamp       `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate   *timestamppb.Timestamp       `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	GroupBy   GetStatisticsRequest_GroupBy `protobuf:"varint,3,opt,name=group_by,json=groupBy,proto3,enum=appointment.GetStatisticsRequest_GroupBy" json:"group_by,omitempty"`
+	// IANA time zone for day buckets, peak hours and working hours.
+	// When empty the server's WORKDAY_TIME_ZONE is used.
+	TimeZone      string `protobuf:"bytes,4,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatisticsRequest) Reset() {
+	*x = GetStatisticsRequest{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatisticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatisticsRequest) ProtoMessage() {}
+
+func (x *GetStatisticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatisticsRequest.ProtoReflect.Descriptor instead.
+func (*GetStatisticsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetStatisticsRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *GetStatisticsRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *GetStatisticsRequest) GetGroupBy() GetStatisticsRequest_GroupBy {
+	if x != nil {
+		return x.GroupBy
+	}
+	return GetStatisticsRequest_NONE
+}
+
+func (x *GetStatisticsRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+type AppointmentStatistics struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Bucket key: YYYY-MM-DD for day and week (week start), calendar ID for calendar
+	Key              string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	PeriodStart      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	PeriodEnd        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	AppointmentCount int32                  `protobuf:"varint,4,opt,name=appointment_count,json=appointmentCount,proto3" json:"appointment_count,omitempty"`
+	BookedHours      float64                `protobuf:"fixed64,5,opt,name=booked_hours,json=bookedHours,proto3" json:"booked_hours,omitempty"`
+	// Working hours in the period, used as the utilization baseline
+	AvailableHours         float64 `protobuf:"fixed64,6,opt,name=available_hours,json=availableHours,proto3" json:"available_hours,omitempty"`
+	Utilization            float64 `protobuf:"fixed64,7,opt,name=utilization,proto3" json:"utilization,omitempty"`
+	AverageDurationMinutes float64 `protobuf:"fixed64,8,opt,name=average_duration_minutes,json=averageDurationMinutes,proto3" json:"average_duration_minutes,omitempty"`
+	// Cancelled appointments are excluded from the other figures
+	CancellationCount int32 `protobuf:"varint,9,opt,name=cancellation_count,json=cancellationCount,proto3" json:"cancellation_count,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AppointmentStatistics) Reset() {
+	*x = AppointmentStatistics{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppointmentStatistics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppointmentStatistics) ProtoMessage() {}
+
+func (x *AppointmentStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppointmentStatistics.ProtoReflect.Descriptor instead.
+func (*AppointmentStatistics) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AppointmentStatistics) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *AppointmentStatistics) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *AppointmentStatistics) GetPeriodEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return nil
+}
+
+func (x *AppointmentStatistics) GetAppointmentCount() int32 {
+	if x != nil {
+		return x.AppointmentCount
+	}
+	return 0
+}
+
+func (x *AppointmentStatistics) GetBookedHours() float64 {
+	if x != nil {
+		return x.BookedHours
+	}
+	return 0
+}
+
+func (x *AppointmentStatistics) GetAvailableHours() float64 {
+	if x != nil {
+		return x.AvailableHours
+	}
+	return 0
+}
+
+func (x *AppointmentStatistics) GetUtilization() float64 {
+	if x != nil {
+		return x.Utilization
+	}
+	return 0
+}
+
+func (x *AppointmentStatistics) GetAverageDurationMinutes() float64 {
+	if x != nil {
+		return x.AverageDurationMinutes
+	}
+	return 0
+}
+
+func (x *AppointmentStatistics) GetCancellationCount() int32 {
+	if x != nil {
+		return x.CancellationCount
+	}
+	return 0
+}
+
+type HourCount struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Hour             int32                  `protobuf:"varint,1,opt,name=hour,proto3" json:"hour,omitempty"`
+	AppointmentCount int32                  `protobuf:"varint,2,opt,name=appointment_count,json=appointmentCount,proto3" json:"appointment_count,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *HourCount) Reset() {
+	*x = HourCount{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HourCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HourCount) ProtoMessage() {}
+
+func (x *HourCount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HourCount.ProtoReflect.Descriptor instead.
+func (*HourCount) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *HourCount) GetHour() int32 {
+	if x != nil {
+		return x.Hour
+	}
+	return 0
+}
+
+func (x *HourCount) GetAppointmentCount() int32 {
+	if x != nil {
+		return x.AppointmentCount
+	}
+	return 0
+}
+
+type GetStatisticsResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Summary       *AppointmentStatistics   `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
+	Groups        []*AppointmentStatistics `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
+	PeakHours     []*HourCount             `protobuf:"bytes,3,rep,name=peak_hours,json=peakHours,proto3" json:"peak_hours,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatisticsResponse) Reset() {
+	*x = GetStatisticsResponse{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatisticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatisticsResponse) ProtoMessage() {}
+
+func (x *GetStatisticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatisticsResponse.ProtoReflect.Descriptor instead.
+func (*GetStatisticsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetStatisticsResponse) GetSummary() *AppointmentStatistics {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *GetStatisticsResponse) GetGroups() []*AppointmentStatistics {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *GetStatisticsResponse) GetPeakHours() []*HourCount {
+	if x != nil {
+		return x.PeakHours
+	}
+	return nil
+}
+
+// One recorded change to an appointment. before is unset for creations and after
+// is unset for purges.
+type AuditEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AppointmentId string                 `protobuf:"bytes,2,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id,omitempty"`
+	// One of created, updated, deleted, restored or purged
+	Action        string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	Actor         string                 `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+	RequestId     string                 `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	Before        *Appointment           `protobuf:"bytes,7,opt,name=before,proto3" json:"before,omitempty"`
+	After         *Appointment           `protobuf:"bytes,8,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent) ProtoMessage() {}
+
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AuditEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetAppointmentId() string {
+	if x != nil {
+		return x.AppointmentId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetBefore() *Appointment {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetAfter() *Appointment {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+type GetAppointmentHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAppointmentHistoryRequest) Reset() {
+	*x = GetAppointmentHistoryRequest{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAppointmentHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAppointmentHistoryRequest) ProtoMessage() {}
+
+func (x *GetAppointmentHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAppointmentHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetAppointmentHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetAppointmentHistoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetAppointmentHistoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Oldest first
+	Events        []*AuditEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAppointmentHistoryResponse) Reset() {
+	*x = GetAppointmentHistoryResponse{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAppointmentHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAppointmentHistoryResponse) ProtoMessage() {}
+
+func (x *GetAppointmentHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAppointmentHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetAppointmentHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetAppointmentHistoryResponse) GetEvents() []*AuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type ListAuditEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	AppointmentId string                 `protobuf:"bytes,3,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id,omitempty"`
+	Actor         string                 `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+	Action        string                 `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsRequest) Reset() {
+	*x = ListAuditEventsRequest{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsRequest) ProtoMessage() {}
+
+func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListAuditEventsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetAppointmentId() string {
+	if x != nil {
+		return x.AppointmentId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type ListAuditEventsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Newest first
+	Events        []*AuditEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Total         int32         `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32         `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32         `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsResponse) Reset() {
+	*x = ListAuditEventsResponse{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsResponse) ProtoMessage() {}
+
+func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListAuditEventsResponse) GetEvents() []*AuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListAuditEventsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListAuditEventsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAuditEventsResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type BatchCreateAppointmentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// At most 500 items
+	Appointments  []*CreateAppointmentRequest `protobuf:"bytes,1,rep,name=appointments,proto3" json:"appointments,omitempty"`
+	Mode          BatchMode                   `protobuf:"varint,2,opt,name=mode,proto3,enum=appointment.BatchMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCreateAppointmentsRequest) Reset() {
+	*x = BatchCreateAppointmentsRequest{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCreateAppointmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateAppointmentsRequest) ProtoMessage() {}
+
+func (x *BatchCreateAppointmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateAppointmentsRequest.ProtoReflect.Descriptor instead.
+func (*BatchCreateAppointmentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *BatchCreateAppointmentsRequest) GetAppointments() []*CreateAppointmentRequest {
+	if x != nil {
+		return x.Appointments
+	}
+	return nil
+}
+
+func (x *BatchCreateAppointmentsRequest) GetMode() BatchMode {
+	if x != nil {
+		return x.Mode
+	}
+	return BatchMode_ATOMIC
+}
+
+type BatchDeleteAppointmentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// At most 500 items
+	Ids           []string  `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	Mode          BatchMode `protobuf:"varint,2,opt,name=mode,proto3,enum=appointment.BatchMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDeleteAppointmentsRequest) Reset() {
+	*x = BatchDeleteAppointmentsRequest{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeleteAppointmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteAppointmentsRequest) ProtoMessage() {}
+
+func (x *BatchDeleteAppointmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteAppointmentsRequest.ProtoReflect.Descriptor instead.
+func (*BatchDeleteAppointmentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *BatchDeleteAppointmentsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *BatchDeleteAppointmentsRequest) GetMode() BatchMode {
+	if x != nil {
+		return x.Mode
+	}
+	return BatchMode_ATOMIC
+}
+
+type BatchItemResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Position of the item in the request
+	Index int32  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Id    string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// The created appointment, or the deleted one as it was before deletion
+	Appointment *Appointment `protobuf:"bytes,3,opt,name=appointment,proto3" json:"appointment,omitempty"`
+	// gRPC status code of the item; OK (0) when it succeeded
+	Code          int32  `protobuf:"varint,4,opt,name=code,proto3" json:"code,omitempty"`
+	Error         string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchItemResult) Reset() {
+	*x = BatchItemResult{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchItemResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchItemResult) ProtoMessage() {}
+
+func (x *BatchItemResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchItemResult.ProtoReflect.Descriptor instead.
+func (*BatchItemResult) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *BatchItemResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BatchItemResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BatchItemResult) GetAppointment() *Appointment {
+	if x != nil {
+		return x.Appointment
+	}
+	return nil
+}
+
+func (x *BatchItemResult) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *BatchItemResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type BatchAppointmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*BatchItemResult     `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Succeeded     int32                  `protobuf:"varint,2,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed        int32                  `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchAppointmentsResponse) Reset() {
+	*x = BatchAppointmentsResponse{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchAppointmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchAppointmentsResponse) ProtoMessage() {}
+
+func (x *BatchAppointmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchAppointmentsResponse.ProtoReflect.Descriptor instead.
+func (*BatchAppointmentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *BatchAppointmentsResponse) GetResults() []*BatchItemResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BatchAppointmentsResponse) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *BatchAppointmentsResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+// Filters as in ListAppointmentsRequest; every matching appointment is exported
+type ExportICSRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Search        string                 `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	CalendarId    string                 `protobuf:"bytes,4,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`
+	Statuses      []AppointmentStatus    `protobuf:"varint,5,rep,packed,name=statuses,proto3,enum=appointment.AppointmentStatus" json:"statuses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportICSRequest) Reset() {
+	*x = ExportICSRequest{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportICSRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportICSRequest) ProtoMessage() {}
+
+func (x *ExportICSRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportICSRequest.ProtoReflect.Descriptor instead.
+func (*ExportICSRequest) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ExportICSRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *ExportICSRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *ExportICSRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *ExportICSRequest) GetCalendarId() string {
+	if x != nil {
+		return x.CalendarId
+	}
+	return ""
+}
+
+func (x *ExportICSRequest) GetStatuses() []AppointmentStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+type ExportICSResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// RFC 5545 VCALENDAR, served as text/calendar
+	Ics           []byte `protobuf:"bytes,1,opt,name=ics,proto3" json:"ics,omitempty"`
+	EventCount    int32  `protobuf:"varint,2,opt,name=event_count,json=eventCount,proto3" json:"event_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportICSResponse) Reset() {
+	*x = ExportICSResponse{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportICSResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportICSResponse) ProtoMessage() {}
+
+func (x *ExportICSResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportICSResponse.ProtoReflect.Descriptor instead.
+func (*ExportICSResponse) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ExportICSResponse) GetIcs() []byte {
+	if x != nil {
+		return x.Ics
+	}
+	return nil
+}
+
+func (x *ExportICSResponse) GetEventCount() int32 {
+	if x != nil {
+		return x.EventCount
+	}
+	return 0
+}
+
+// The first message carries the options, the following ones the .ics file in chunks
+type ImportICSRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*ImportICSRequest_Options
+	//	*ImportICSRequest_Chunk
+	Payload       isImportICSRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportICSRequest) Reset() {
+	*x = ImportICSRequest{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportICSRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportICSRequest) ProtoMessage() {}
+
+func (x *ImportICSRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportICSRequest.ProtoReflect.Descriptor instead.
+func (*ImportICSRequest) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ImportICSRequest) GetPayload() isImportICSRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ImportICSRequest) GetOptions() *ImportICSOptions {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportICSRequest_Options); ok {
+			return x.Options
+		}
+	}
+	return nil
+}
+
+func (x *ImportICSRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportICSRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isImportICSRequest_Payload interface {
+	isImportICSRequest_Payload()
+}
+
+type ImportICSRequest_Options struct {
+	Options *ImportICSOptions `protobuf:"bytes,1,opt,name=options,proto3,oneof"`
+}
+
+type ImportICSRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*ImportICSRequest_Options) isImportICSRequest_Payload() {}
+
+func (*ImportICSRequest_Chunk) isImportICSRequest_Payload() {}
+
+type ImportICSOptions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Calendar the events are imported into
+	CalendarId string `protobuf:"bytes,1,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`
+	// IANA time zone for floating times and all-day events; defaults to UTC
+	TimeZone string `protobuf:"bytes,2,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// Report what would happen without creating anything
+	DryRun        bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportICSOptions) Reset() {
+	*x = ImportICSOptions{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportICSOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportICSOptions) ProtoMessage() {}
+
+func (x *ImportICSOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportICSOptions.ProtoReflect.Descriptor instead.
+func (*ImportICSOptions) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ImportICSOptions) GetCalendarId() string {
+	if x != nil {
+		return x.CalendarId
+	}
+	return ""
+}
+
+func (x *ImportICSOptions) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *ImportICSOptions) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportedEvent struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Uid       string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Title     string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Outcome   ImportedEvent_Outcome  `protobuf:"varint,5,opt,name=outcome,proto3,enum=appointment.ImportedEvent_Outcome" json:"outcome,omitempty"`
+	// The created appointment, or the existing one for duplicates
+	AppointmentId string `protobuf:"bytes,6,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id,omitempty"`
+	Error         string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportedEvent) Reset() {
+	*x = ImportedEvent{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportedEvent) ProtoMessage() {}
+
+func (x *ImportedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportedEvent.ProtoReflect.Descriptor instead.
+func (*ImportedEvent) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ImportedEvent) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *ImportedEvent) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ImportedEvent) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ImportedEvent) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ImportedEvent) GetOutcome() ImportedEvent_Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return ImportedEvent_IMPORTED
+}
+
+func (x *ImportedEvent) GetAppointmentId() string {
+	if x != nil {
+		return x.AppointmentId
+	}
+	return ""
+}
+
+func (x *ImportedEvent) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ImportICSReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DryRun        bool                   `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Imported      int32                  `protobuf:"varint,2,opt,name=imported,proto3" json:"imported,omitempty"`
+	Duplicates    int32                  `protobuf:"varint,3,opt,name=duplicates,proto3" json:"duplicates,omitempty"`
+	Conflicts     int32                  `protobuf:"varint,4,opt,name=conflicts,proto3" json:"conflicts,omitempty"`
+	Failed        int32                  `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`
+	Events        []*ImportedEvent       `protobuf:"bytes,6,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportICSReport) Reset() {
+	*x = ImportICSReport{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportICSReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportICSReport) ProtoMessage() {}
+
+func (x *ImportICSReport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportICSReport.ProtoReflect.Descriptor instead.
+func (*ImportICSReport) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ImportICSReport) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportICSReport) GetImported() int32 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+func (x *ImportICSReport) GetDuplicates() int32 {
+	if x != nil {
+		return x.Duplicates
+	}
+	return 0
+}
+
+func (x *ImportICSReport) GetConflicts() int32 {
+	if x != nil {
+		return x.Conflicts
+	}
+	return 0
+}
+
+func (x *ImportICSReport) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ImportICSReport) GetEvents() []*ImportedEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// Filters as in ListAppointmentsRequest; every matching appointment is exported
+type ExportAppointmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        RecordFormat           `protobuf:"varint,1,opt,name=format,proto3,enum=appointment.RecordFormat" json:"format,omitempty"`
+	Search        string                 `protobuf:"bytes,2,opt,name=search,proto3" json:"search,omitempty"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	CalendarId    string                 `protobuf:"bytes,5,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`
+	Statuses      []AppointmentStatus    `protobuf:"varint,6,rep,packed,name=statuses,proto3,enum=appointment.AppointmentStatus" json:"statuses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportAppointmentsRequest) Reset() {
+	*x = ExportAppointmentsRequest{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportAppointmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAppointmentsRequest) ProtoMessage() {}
+
+func (x *ExportAppointmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAppointmentsRequest.ProtoReflect.Descriptor instead.
+func (*ExportAppointmentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ExportAppointmentsRequest) GetFormat() RecordFormat {
+	if x != nil {
+		return x.Format
+	}
+	return RecordFormat_CSV
+}
+
+func (x *ExportAppointmentsRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *ExportAppointmentsRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *ExportAppointmentsRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *ExportAppointmentsRequest) GetCalendarId() string {
+	if x != nil {
+		return x.CalendarId
+	}
+	return ""
+}
+
+func (x *ExportAppointmentsRequest) GetStatuses() []AppointmentStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+// A piece of the exported file; concatenate data in the order received
+type ExportAppointmentsChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportAppointmentsChunk) Reset() {
+	*x = ExportAppointmentsChunk{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportAppointmentsChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAppointmentsChunk) ProtoMessage() {}
+
+func (x *ExportAppointmentsChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAppointmentsChunk.ProtoReflect.Descriptor instead.
+func (*ExportAppointmentsChunk) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ExportAppointmentsChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// The first message carries the options, the following ones the file in chunks
+type ImportAppointmentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*ImportAppointmentsRequest_Options
+	//	*ImportAppointmentsRequest_Chunk
+	Payload       isImportAppointmentsRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportAppointmentsRequest) Reset() {
+	*x = ImportAppointmentsRequest{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportAppointmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportAppointmentsRequest) ProtoMessage() {}
+
+func (x *ImportAppointmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportAppointmentsRequest.ProtoReflect.Descriptor instead.
+func (*ImportAppointmentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ImportAppointmentsRequest) GetPayload() isImportAppointmentsRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ImportAppointmentsRequest) GetOptions() *ImportAppointmentsOptions {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportAppointmentsRequest_Options); ok {
+			return x.Options
+		}
+	}
+	return nil
+}
+
+func (x *ImportAppointmentsRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportAppointmentsRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isImportAppointmentsRequest_Payload interface {
+	isImportAppointmentsRequest_Payload()
+}
+
+type ImportAppointmentsRequest_Options struct {
+	Options *ImportAppointmentsOptions `protobuf:"bytes,1,opt,name=options,proto3,oneof"`
+}
+
+type ImportAppointmentsRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*ImportAppointmentsRequest_Options) isImportAppointmentsRequest_Payload() {}
+
+func (*ImportAppointmentsRequest_Chunk) isImportAppointmentsRequest_Payload() {}
+
+type ImportAppointmentsOptions struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Format RecordFormat           `protobuf:"varint,1,opt,name=format,proto3,enum=appointment.RecordFormat" json:"format,omitempty"`
+	// Used for rows that leave calendar_id empty
+	CalendarId string `protobuf:"bytes,2,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`
+	// IANA time zone used for rows that leave time_zone empty; defaults to UTC
+	TimeZone string    `protobuf:"bytes,3,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	Mode     BatchMode `protobuf:"varint,4,opt,name=mode,proto3,enum=appointment.BatchMode" json:"mode,omitempty"`
+	// Report what would happen without creating anything
+	DryRun        bool `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportAppointmentsOptions) Reset() {
+	*x = ImportAppointmentsOptions{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportAppointmentsOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportAppointmentsOptions) ProtoMessage() {}
+
+func (x *ImportAppointmentsOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportAppointmentsOptions.ProtoReflect.Descriptor instead.
+func (*ImportAppointmentsOptions) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ImportAppointmentsOptions) GetFormat() RecordFormat {
+	if x != nil {
+		return x.Format
+	}
+	return RecordFormat_CSV
+}
+
+func (x *ImportAppointmentsOptions) GetCalendarId() string {
+	if x != nil {
+		return x.CalendarId
+	}
+	return ""
+}
+
+func (x *ImportAppointmentsOptions) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *ImportAppointmentsOptions) GetMode() BatchMode {
+	if x != nil {
+		return x.Mode
+	}
+	return BatchMode_ATOMIC
+}
+
+func (x *ImportAppointmentsOptions) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportedRow struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Line of the file the row starts on, counting from 1
+	Line int32 `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	// The created appointment; empty on dry runs and failed atomic imports
+	AppointmentId string `protobuf:"bytes,2,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id,omitempty"`
+	// gRPC status code of the row; OK (0) when it passed
+	Code          int32  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	Error         string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportedRow) Reset() {
+	*x = ImportedRow{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportedRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportedRow) ProtoMessage() {}
+
+func (x *ImportedRow) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportedRow.ProtoReflect.Descriptor instead.
+func (*ImportedRow) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ImportedRow) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportedRow) GetAppointmentId() string {
+	if x != nil {
+		return x.AppointmentId
+	}
+	return ""
+}
+
+func (x *ImportedRow) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ImportedRow) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ImportAppointmentsReport struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Mode   BatchMode              `protobuf:"varint,1,opt,name=mode,proto3,enum=appointment.BatchMode" json:"mode,omitempty"`
+	DryRun bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// Whether any row was written
+	Committed     bool           `protobuf:"varint,3,opt,name=committed,proto3" json:"committed,omitempty"`
+	Succeeded     int32          `protobuf:"varint,4,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed        int32          `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`
+	Rows          []*ImportedRow `protobuf:"bytes,6,rep,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportAppointmentsReport) Reset() {
+	*x = ImportAppointmentsReport{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportAppointmentsReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportAppointmentsReport) ProtoMessage() {}
+
+func (x *ImportAppointmentsReport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportAppointmentsReport.ProtoReflect.Descriptor instead.
+func (*ImportAppointmentsReport) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ImportAppointmentsReport) GetMode() BatchMode {
+	if x != nil {
+		return x.Mode
+	}
+	return BatchMode_ATOMIC
+}
+
+func (x *ImportAppointmentsReport) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportAppointmentsReport) GetCommitted() bool {
+	if x != nil {
+		return x.Committed
+	}
+	return false
+}
+
+func (x *ImportAppointmentsReport) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *ImportAppointmentsReport) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ImportAppointmentsReport) GetRows() []*ImportedRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+// A secret URL publishing a calendar as an iCalendar feed. token and url are only
+// returned by CreateFeedToken and RotateFeedToken.
+type FeedToken struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CalendarId    string                 `protobuf:"bytes,2,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`
+	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	Url           string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	RotatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=rotated_at,json=rotatedAt,proto3" json:"rotated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeedToken) Reset() {
+	*x = FeedToken{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeedToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedToken) ProtoMessage() {}
+
+func (x *FeedToken) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedToken.ProtoReflect.Descriptor instead.
+func (*FeedToken) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *FeedToken) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FeedToken) GetCalendarId() string {
+	if x != nil {
+		return x.CalendarId
+	}
+	return ""
+}
+
+func (x *FeedToken) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *FeedToken) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *FeedToken) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *FeedToken) GetRotatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RotatedAt
+	}
+	return nil
+}
+
+type CreateFeedTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CalendarId    string                 `protobuf:"bytes,1,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFeedTokenRequest) Reset() {
+	*x = CreateFeedTokenRequest{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFeedTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFeedTokenRequest) ProtoMessage() {}
+
+func (x *CreateFeedTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFeedTokenRequest.ProtoReflect.Descriptor instead.
+func (*CreateFeedTokenRequest) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CreateFeedTokenRequest) GetCalendarId() string {
+	if x != nil {
+		return x.CalendarId
+	}
+	return ""
+}
+
+// Issues a new secret for the feed; the old URL stops working
+type RotateFeedTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateFeedTokenRequest) Reset() {
+	*x = RotateFeedTokenRequest{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateFeedTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateFeedTokenRequest) ProtoMessage() {}
+
+func (x *RotateFeedTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateFeedTokenRequest.ProtoReflect.Descriptor instead.
+func (*RotateFeedTokenRequest) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *RotateFeedTokenRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RevokeFeedTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeFeedTokenRequest) Reset() {
+	*x = RevokeFeedTokenRequest{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeFeedTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeFeedTokenRequest) ProtoMessage() {}
+
+func (x *RevokeFeedTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeFeedTokenRequest.ProtoReflect.Descriptor instead.
+func (*RevokeFeedTokenRequest) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RevokeFeedTokenRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListFeedTokensRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CalendarId    string                 `protobuf:"bytes,1,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFeedTokensRequest) Reset() {
+	*x = ListFeedTokensRequest{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFeedTokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFeedTokensRequest) ProtoMessage() {}
+
+func (x *ListFeedTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFeedTokensRequest.ProtoReflect.Descriptor instead.
+func (*ListFeedTokensRequest) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *CreateAppointmentRequest) GetTitle() string {
+func (x *ListFeedTokensRequest) GetCalendarId() string {
 	if x != nil {
-		return x.Title
+		return x.CalendarId
 	}
 	return ""
 }
 
-func (x *CreateAppointmentRequest) GetStartTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.StartTime
-	}
-	return nil
-}
-
-func (x *CreateAppointmentRequest) GetEndTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.EndTime
-	}
-	return nil
-}
-
-type GetAppointmentRequest struct {
+type ListFeedTokensResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FeedTokens    []*FeedToken           `protobuf:"bytes,1,rep,name=feed_tokens,json=feedTokens,proto3" json:"feed_tokens,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAppointmentRequest) Reset() {
-	*x = GetAppointmentRequest{}
-	mi := &file_proto_appointment_appointment_proto_msgTypes[2]
+func (x *ListFeedTokensResponse) Reset() {
+	*x = ListFeedTokensResponse{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAppointmentRequest) String() string {
+func (x *ListFeedTokensResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAppointmentRequest) ProtoMessage() {}
+func (*ListFeedTokensResponse) ProtoMessage() {}
 
-func (x *GetAppointmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_appointment_appointment_proto_msgTypes[2]
+func (x *ListFeedTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -250,43 +3576,51 @@ func (x *GetAppointmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAppointmentRequest.ProtoReflect.Descriptor instead.
-func (*GetAppointmentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ListFeedTokensResponse.ProtoReflect.Descriptor instead.
+func (*ListFeedTokensResponse) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *GetAppointmentRequest) GetId() string {
+func (x *ListFeedTokensResponse) GetFeedTokens() []*FeedToken {
 	if x != nil {
-		return x.Id
+		return x.FeedTokens
 	}
-	return ""
+	return nil
 }
 
-type UpdateAppointmentRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	StartTime     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+// A key letting a service call the API without an interactive login. It is sent
+// as x-api-key metadata. key is only returned by CreateAPIKey; prefix identifies
+// the key afterwards.
+type APIKey struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// appointments:read and/or appointments:write
+	Scopes        []string               `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	Prefix        string                 `protobuf:"bytes,4,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Key           string                 `protobuf:"bytes,5,opt,name=key,proto3" json:"key,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,6,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateAppointmentRequest) Reset() {
-	*x = UpdateAppointmentRequest{}
-	mi := &file_proto_appointment_appointment_proto_msgTypes[3]
+func (x *APIKey) Reset() {
+	*x = APIKey{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateAppointmentRequest) String() string {
+func (x *APIKey) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAppointmentRequest) ProtoMessage() {}
+func (*APIKey) ProtoMessage() {}
 
-func (x *UpdateAppointmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_appointment_appointment_proto_msgTypes[3]
+func (x *APIKey) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -297,61 +3631,90 @@ func (x *UpdateAppointmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAppointmentRequest.ProtoReflect.Descriptor instead.
-func (*UpdateAppointmentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use APIKey.ProtoReflect.Descriptor instead.
+func (*APIKey) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *UpdateAppointmentRequest) GetId() string {
+func (x *APIKey) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateAppointmentRequest) GetTitle() string {
+func (x *APIKey) GetName() string {
 	if x != nil {
-		return x.Title
+		return x.Name
 	}
 	return ""
 }
 
-func (x *UpdateAppointmentRequest) GetStartTime() *timestamppb.Timestamp {
+func (x *APIKey) GetScopes() []string {
 	if x != nil {
-		return x.StartTime
+		return x.Scopes
 	}
 	return nil
 }
 
-func (x *UpdateAppointmentRequest) GetEndTime() *timestamppb.Timestamp {
+func (x *APIKey) GetPrefix() string {
 	if x != nil {
-		return x.EndTime
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *APIKey) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *APIKey) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *APIKey) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type DeleteAppointmentRequest struct {
+func (x *APIKey) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+type CreateAPIKeyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes        []string               `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteAppointmentRequest) Reset() {
-	*x = DeleteAppointmentRequest{}
-	mi := &file_proto_appointment_appointment_proto_msgTypes[4]
+func (x *CreateAPIKeyRequest) Reset() {
+	*x = CreateAPIKeyRequest{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteAppointmentRequest) String() string {
+func (x *CreateAPIKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteAppointmentRequest) ProtoMessage() {}
+func (*CreateAPIKeyRequest) ProtoMessage() {}
 
-func (x *DeleteAppointmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_appointment_appointment_proto_msgTypes[4]
+func (x *CreateAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -362,44 +3725,46 @@ func (x *DeleteAppointmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteAppointmentRequest.ProtoReflect.Descriptor instead.
-func (*DeleteAppointmentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use CreateAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *DeleteAppointmentRequest) GetId() string {
+func (x *CreateAPIKeyRequest) GetName() string {
 	if x != nil {
-		return x.Id
+		return x.Name
 	}
 	return ""
 }
 
-type ListAppointmentsRequest struct {
+func (x *CreateAPIKeyRequest) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+type ListAPIKeysRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Search        string                 `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`
-	StartDate     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAppointmentsRequest) Reset() {
-	*x = ListAppointmentsRequest{}
-	mi := &file_proto_appointment_appointment_proto_msgTypes[5]
+func (x *ListAPIKeysRequest) Reset() {
+	*x = ListAPIKeysRequest{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAppointmentsRequest) String() string {
+func (x *ListAPIKeysRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAppointmentsRequest) ProtoMessage() {}
+func (*ListAPIKeysRequest) ProtoMessage() {}
 
-func (x *ListAppointmentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_appointment_appointment_proto_msgTypes[5]
+func (x *ListAPIKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -410,71 +3775,77 @@ func (x *ListAppointmentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAppointmentsRequest.ProtoReflect.Descriptor instead.
-func (*ListAppointmentsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ListAPIKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListAPIKeysRequest) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *ListAppointmentsRequest) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
+type ListAPIKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKeys       []*APIKey              `protobuf:"bytes,1,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAppointmentsRequest) GetLimit() int32 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
+func (x *ListAPIKeysResponse) Reset() {
+	*x = ListAPIKeysResponse{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAppointmentsRequest) GetSearch() string {
-	if x != nil {
-		return x.Search
-	}
-	return ""
+func (x *ListAPIKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ListAppointmentsRequest) GetStartDate() *timestamppb.Timestamp {
+func (*ListAPIKeysResponse) ProtoMessage() {}
+
+func (x *ListAPIKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[51]
 	if x != nil {
-		return x.StartDate
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *ListAppointmentsRequest) GetEndDate() *timestamppb.Timestamp {
+// Deprecated: Use ListAPIKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListAPIKeysResponse) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListAPIKeysResponse) GetApiKeys() []*APIKey {
 	if x != nil {
-		return x.EndDate
+		return x.ApiKeys
 	}
 	return nil
 }
 
-type ListAppointmentsResponse struct {
+type RevokeAPIKeyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Appointments  []*Appointment         `protobuf:"bytes,1,rep,name=appointments,proto3" json:"appointments,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAppointmentsResponse) Reset() {
-	*x = ListAppointmentsResponse{}
-	mi := &file_proto_appointment_appointment_proto_msgTypes[6]
+func (x *RevokeAPIKeyRequest) Reset() {
+	*x = RevokeAPIKeyRequest{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAppointmentsResponse) String() string {
+func (x *RevokeAPIKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAppointmentsResponse) ProtoMessage() {}
+func (*RevokeAPIKeyRequest) ProtoMessage() {}
 
-func (x *ListAppointmentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_appointment_appointment_proto_msgTypes[6]
+func (x *RevokeAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -485,62 +3856,89 @@ func (x *ListAppointmentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAppointmentsResponse.ProtoReflect.Descriptor instead.
-func (*ListAppointmentsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use RevokeAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *ListAppointmentsResponse) GetAppointments() []*Appointment {
+func (x *RevokeAPIKeyRequest) GetId() string {
 	if x != nil {
-		return x.Appointments
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *ListAppointmentsResponse) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
+// Error details attached to ALREADY_EXISTS errors caused by a time conflict,
+// listing the appointments the write would overlap. Only IDs and times are
+// given, since the caller may not be allowed to view the appointments.
+type ConflictDetails struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Conflicts     []*ConflictingAppointment `protobuf:"bytes,1,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAppointmentsResponse) GetPage() int32 {
+func (x *ConflictDetails) Reset() {
+	*x = ConflictDetails{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConflictDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConflictDetails) ProtoMessage() {}
+
+func (x *ConflictDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[53]
 	if x != nil {
-		return x.Page
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ListAppointmentsResponse) GetLimit() int32 {
+// Deprecated: Use ConflictDetails.ProtoReflect.Descriptor instead.
+func (*ConflictDetails) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ConflictDetails) GetConflicts() []*ConflictingAppointment {
 	if x != nil {
-		return x.Limit
+		return x.Conflicts
 	}
-	return 0
+	return nil
 }
 
-type AppointmentStreamResponse struct {
-	state         protoimpl.MessageState              `protogen:"open.v1"`
-	EventType     AppointmentStreamResponse_EventType `protobuf:"varint,1,opt,name=event_type,json=eventType,proto3,enum=appointment.AppointmentStreamResponse_EventType" json:"event_type,omitempty"`
-	Appointment   *Appointment                        `protobuf:"bytes,2,opt,name=appointment,proto3" json:"appointment,omitempty"`
+type ConflictingAppointment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AppointmentStreamResponse) Reset() {
-	*x = AppointmentStreamResponse{}
-	mi := &file_proto_appointment_appointment_proto_msgTypes[7]
+func (x *ConflictingAppointment) Reset() {
+	*x = ConflictingAppointment{}
+	mi := &file_proto_appointment_appointment_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AppointmentStreamResponse) String() string {
+func (x *ConflictingAppointment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AppointmentStreamResponse) ProtoMessage() {}
+func (*ConflictingAppointment) ProtoMessage() {}
 
-func (x *AppointmentStreamResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_appointment_appointment_proto_msgTypes[7]
+func (x *ConflictingAppointment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_appointment_appointment_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -551,21 +3949,28 @@ func (x *AppointmentStreamResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AppointmentStreamResponse.ProtoReflect.Descriptor instead.
-func (*AppointmentStreamResponse) Descriptor() ([]byte, []int) {
-	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ConflictingAppointment.ProtoReflect.Descriptor instead.
+func (*ConflictingAppointment) Descriptor() ([]byte, []int) {
+	return file_proto_appointment_appointment_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *AppointmentStreamResponse) GetEventType() AppointmentStreamResponse_EventType {
+func (x *ConflictingAppointment) GetId() string {
 	if x != nil {
-		return x.EventType
+		return x.Id
 	}
-	return AppointmentStreamResponse_CREATED
+	return ""
 }
 
-func (x *AppointmentStreamResponse) GetAppointment() *Appointment {
+func (x *ConflictingAppointment) GetStartTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Appointment
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ConflictingAppointment) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
 	}
 	return nil
 }
@@ -574,7 +3979,7 @@ var File_proto_appointment_appointment_proto protoreflect.FileDescriptor
 
 const file_proto_appointment_appointment_proto_rawDesc = "" +
 	"\n" +
-	"#proto/appointment/appointment.proto\x12\vappointment\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"\x9b\x02\n" +
+	"#proto/appointment/appointment.proto\x12\vappointment\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1cgoogle/api/annotations.proto\"\xa3\x06\n" +
 	"\vAppointment\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x129\n" +
@@ -584,29 +3989,77 @@ const file_proto_appointment_appointment_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xa2\x01\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1f\n" +
+	"\vcalendar_id\x18\a \x01(\tR\n" +
+	"calendarId\x12\x1b\n" +
+	"\ttime_zone\x18\b \x01(\tR\btimeZone\x12\x17\n" +
+	"\aall_day\x18\t \x01(\bR\x06allDay\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\n" +
+	" \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\v \x01(\tR\aendDate\x12B\n" +
+	"\x0fhold_expires_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\rholdExpiresAt\x126\n" +
+	"\x06status\x18\r \x01(\x0e2\x1e.appointment.AppointmentStatusR\x06status\x12/\n" +
+	"\x13cancellation_reason\x18\x0e \x01(\tR\x12cancellationReason\x12=\n" +
+	"\fcancelled_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\vcancelledAt\x129\n" +
+	"\n" +
+	"deleted_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x19\n" +
+	"\bical_uid\x18\x11 \x01(\tR\aicalUid\x12\x14\n" +
+	"\x05owner\x18\x12 \x01(\tR\x05owner\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x13 \x01(\tR\tcreatedBy\"\xb3\x02\n" +
 	"\x18CreateAppointmentRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x129\n" +
 	"\n" +
 	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
-	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\"'\n" +
-	"\x15GetAppointmentRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\xb2\x01\n" +
-	"\x18UpdateAppointmentRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
-	"\x05title\x18\x02 \x01(\tR\x05title\x129\n" +
+	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x1f\n" +
+	"\vcalendar_id\x18\x04 \x01(\tR\n" +
+	"calendarId\x12\x1b\n" +
+	"\ttime_zone\x18\x05 \x01(\tR\btimeZone\x12\x17\n" +
+	"\aall_day\x18\x06 \x01(\bR\x06allDay\x12\x1d\n" +
 	"\n" +
-	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
-	"\bend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\"*\n" +
+	"start_date\x18\a \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\b \x01(\tR\aendDate\"\x84\x02\n" +
+	"\x0fHoldSlotRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x129\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x1f\n" +
+	"\vcalendar_id\x18\x04 \x01(\tR\n" +
+	"calendarId\x12\x1b\n" +
+	"\ttime_zone\x18\x05 \x01(\tR\btimeZone\x12+\n" +
+	"\x03ttl\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\x03ttl\":\n" +
+	"\x12ConfirmHoldRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\"'\n" +
+	"\x15GetAppointmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"*\n" +
 	"\x18DeleteAppointmentRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\xcd\x01\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"+\n" +
+	"\x19RestoreAppointmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"J\n" +
+	"\x1eListDeletedAppointmentsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\")\n" +
+	"\x17PurgeAppointmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"B\n" +
+	"\x18CancelAppointmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x80\x01\n" +
+	"\x1eUpdateAppointmentStatusRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x126\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1e.appointment.AppointmentStatusR\x06status\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\xaa\x02\n" +
 	"\x17ListAppointmentsRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
 	"\x06search\x18\x03 \x01(\tR\x06search\x129\n" +
 	"\n" +
 	"start_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
-	"\bend_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\"\x98\x01\n" +
+	"\bend_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x1f\n" +
+	"\vcalendar_id\x18\x06 \x01(\tR\n" +
+	"calendarId\x12:\n" +
+	"\bstatuses\x18\a \x03(\x0e2\x1e.appointment.AppointmentStatusR\bstatuses\"\x98\x01\n" +
 	"\x18ListAppointmentsResponse\x12<\n" +
 	"\fappointments\x18\x01 \x03(\v2\x18.appointment.AppointmentR\fappointments\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
@@ -619,13 +4072,269 @@ const file_proto_appointment_appointment_proto_rawDesc = "" +
 	"\tEventType\x12\v\n" +
 	"\aCREATED\x10\x00\x12\v\n" +
 	"\aUPDATED\x10\x01\x12\v\n" +
-	"\aDELETED\x10\x022\x9d\x04\n" +
-	"\x12AppointmentService\x12T\n" +
-	"\x11CreateAppointment\x12%.appointment.CreateAppointmentRequest\x1a\x18.appointment.Appointment\x12N\n" +
-	"\x0eGetAppointment\x12\".appointment.GetAppointmentRequest\x1a\x18.appointment.Appointment\x12T\n" +
-	"\x11UpdateAppointment\x12%.appointment.UpdateAppointmentRequest\x1a\x18.appointment.Appointment\x12R\n" +
-	"\x11DeleteAppointment\x12%.appointment.DeleteAppointmentRequest\x1a\x16.google.protobuf.Empty\x12_\n" +
-	"\x10ListAppointments\x12$.appointment.ListAppointmentsRequest\x1a%.appointment.ListAppointmentsResponse\x12V\n" +
+	"\aDELETED\x10\x02\"\x9d\x01\n" +
+	"\x10CalendarSettings\x12\x1f\n" +
+	"\vcalendar_id\x18\x01 \x01(\tR\n" +
+	"calendarId\x12-\n" +
+	"\x13all_day_blocks_time\x18\x02 \x01(\bR\x10allDayBlocksTime\x129\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"=\n" +
+	"\x1aGetCalendarSettingsRequest\x12\x1f\n" +
+	"\vcalendar_id\x18\x01 \x01(\tR\n" +
+	"calendarId\"o\n" +
+	"\x1dUpdateCalendarSettingsRequest\x12\x1f\n" +
+	"\vcalendar_id\x18\x01 \x01(\tR\n" +
+	"calendarId\x12-\n" +
+	"\x13all_day_blocks_time\x18\x02 \x01(\bR\x10allDayBlocksTime\"\xa1\x02\n" +
+	"\x14GetStatisticsRequest\x129\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12D\n" +
+	"\bgroup_by\x18\x03 \x01(\x0e2).appointment.GetStatisticsRequest.GroupByR\agroupBy\x12\x1b\n" +
+	"\ttime_zone\x18\x04 \x01(\tR\btimeZone\"4\n" +
+	"\aGroupBy\x12\b\n" +
+	"\x04NONE\x10\x00\x12\a\n" +
+	"\x03DAY\x10\x01\x12\b\n" +
+	"\x04WEEK\x10\x02\x12\f\n" +
+	"\bCALENDAR\x10\x03\"\xa7\x03\n" +
+	"\x15AppointmentStatistics\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12=\n" +
+	"\fperiod_start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x129\n" +
+	"\n" +
+	"period_end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tperiodEnd\x12+\n" +
+	"\x11appointment_count\x18\x04 \x01(\x05R\x10appointmentCount\x12!\n" +
+	"\fbooked_hours\x18\x05 \x01(\x01R\vbookedHours\x12'\n" +
+	"\x0favailable_hours\x18\x06 \x01(\x01R\x0eavailableHours\x12 \n" +
+	"\vutilization\x18\a \x01(\x01R\vutilization\x128\n" +
+	"\x18average_duration_minutes\x18\b \x01(\x01R\x16averageDurationMinutes\x12-\n" +
+	"\x12cancellation_count\x18\t \x01(\x05R\x11cancellationCount\"L\n" +
+	"\tHourCount\x12\x12\n" +
+	"\x04hour\x18\x01 \x01(\x05R\x04hour\x12+\n" +
+	"\x11appointment_count\x18\x02 \x01(\x05R\x10appointmentCount\"\xc8\x01\n" +
+	"\x15GetStatisticsResponse\x12<\n" +
+	"\asummary\x18\x01 \x01(\v2\".appointment.AppointmentStatisticsR\asummary\x12:\n" +
+	"\x06groups\x18\x02 \x03(\v2\".appointment.AppointmentStatisticsR\x06groups\x125\n" +
+	"\n" +
+	"peak_hours\x18\x03 \x03(\v2\x16.appointment.HourCountR\tpeakHours\"\xaf\x02\n" +
+	"\n" +
+	"AuditEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12%\n" +
+	"\x0eappointment_id\x18\x02 \x01(\tR\rappointmentId\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12\x14\n" +
+	"\x05actor\x18\x04 \x01(\tR\x05actor\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x05 \x01(\tR\trequestId\x12;\n" +
+	"\voccurred_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x120\n" +
+	"\x06before\x18\a \x01(\v2\x18.appointment.AppointmentR\x06before\x12.\n" +
+	"\x05after\x18\b \x01(\v2\x18.appointment.AppointmentR\x05after\".\n" +
+	"\x1cGetAppointmentHistoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"P\n" +
+	"\x1dGetAppointmentHistoryResponse\x12/\n" +
+	"\x06events\x18\x01 \x03(\v2\x17.appointment.AuditEventR\x06events\"\x89\x02\n" +
+	"\x16ListAuditEventsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12%\n" +
+	"\x0eappointment_id\x18\x03 \x01(\tR\rappointmentId\x12\x14\n" +
+	"\x05actor\x18\x04 \x01(\tR\x05actor\x12\x16\n" +
+	"\x06action\x18\x05 \x01(\tR\x06action\x129\n" +
+	"\n" +
+	"start_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\"\x8a\x01\n" +
+	"\x17ListAuditEventsResponse\x12/\n" +
+	"\x06events\x18\x01 \x03(\v2\x17.appointment.AuditEventR\x06events\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"\x97\x01\n" +
+	"\x1eBatchCreateAppointmentsRequest\x12I\n" +
+	"\fappointments\x18\x01 \x03(\v2%.appointment.CreateAppointmentRequestR\fappointments\x12*\n" +
+	"\x04mode\x18\x02 \x01(\x0e2\x16.appointment.BatchModeR\x04mode\"^\n" +
+	"\x1eBatchDeleteAppointmentsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\x12*\n" +
+	"\x04mode\x18\x02 \x01(\x0e2\x16.appointment.BatchModeR\x04mode\"\x9d\x01\n" +
+	"\x0fBatchItemResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12:\n" +
+	"\vappointment\x18\x03 \x01(\v2\x18.appointment.AppointmentR\vappointment\x12\x12\n" +
+	"\x04code\x18\x04 \x01(\x05R\x04code\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\"\x89\x01\n" +
+	"\x19BatchAppointmentsResponse\x126\n" +
+	"\aresults\x18\x01 \x03(\v2\x1c.appointment.BatchItemResultR\aresults\x12\x1c\n" +
+	"\tsucceeded\x18\x02 \x01(\x05R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x05R\x06failed\"\xf9\x01\n" +
+	"\x10ExportICSRequest\x12\x16\n" +
+	"\x06search\x18\x01 \x01(\tR\x06search\x129\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x1f\n" +
+	"\vcalendar_id\x18\x04 \x01(\tR\n" +
+	"calendarId\x12:\n" +
+	"\bstatuses\x18\x05 \x03(\x0e2\x1e.appointment.AppointmentStatusR\bstatuses\"F\n" +
+	"\x11ExportICSResponse\x12\x10\n" +
+	"\x03ics\x18\x01 \x01(\fR\x03ics\x12\x1f\n" +
+	"\vevent_count\x18\x02 \x01(\x05R\n" +
+	"eventCount\"p\n" +
+	"\x10ImportICSRequest\x129\n" +
+	"\aoptions\x18\x01 \x01(\v2\x1d.appointment.ImportICSOptionsH\x00R\aoptions\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\apayload\"i\n" +
+	"\x10ImportICSOptions\x12\x1f\n" +
+	"\vcalendar_id\x18\x01 \x01(\tR\n" +
+	"calendarId\x12\x1b\n" +
+	"\ttime_zone\x18\x02 \x01(\tR\btimeZone\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\"\xe6\x02\n" +
+	"\rImportedEvent\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\tR\x03uid\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x129\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12<\n" +
+	"\aoutcome\x18\x05 \x01(\x0e2\".appointment.ImportedEvent.OutcomeR\aoutcome\x12%\n" +
+	"\x0eappointment_id\x18\x06 \x01(\tR\rappointmentId\x12\x14\n" +
+	"\x05error\x18\a \x01(\tR\x05error\"@\n" +
+	"\aOutcome\x12\f\n" +
+	"\bIMPORTED\x10\x00\x12\r\n" +
+	"\tDUPLICATE\x10\x01\x12\f\n" +
+	"\bCONFLICT\x10\x02\x12\n" +
+	"\n" +
+	"\x06FAILED\x10\x03\"\xd0\x01\n" +
+	"\x0fImportICSReport\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\x12\x1a\n" +
+	"\bimported\x18\x02 \x01(\x05R\bimported\x12\x1e\n" +
+	"\n" +
+	"duplicates\x18\x03 \x01(\x05R\n" +
+	"duplicates\x12\x1c\n" +
+	"\tconflicts\x18\x04 \x01(\x05R\tconflicts\x12\x16\n" +
+	"\x06failed\x18\x05 \x01(\x05R\x06failed\x122\n" +
+	"\x06events\x18\x06 \x03(\v2\x1a.appointment.ImportedEventR\x06events\"\xb5\x02\n" +
+	"\x19ExportAppointmentsRequest\x121\n" +
+	"\x06format\x18\x01 \x01(\x0e2\x19.appointment.RecordFormatR\x06format\x12\x16\n" +
+	"\x06search\x18\x02 \x01(\tR\x06search\x129\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x1f\n" +
+	"\vcalendar_id\x18\x05 \x01(\tR\n" +
+	"calendarId\x12:\n" +
+	"\bstatuses\x18\x06 \x03(\x0e2\x1e.appointment.AppointmentStatusR\bstatuses\"-\n" +
+	"\x17ExportAppointmentsChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"\x82\x01\n" +
+	"\x19ImportAppointmentsRequest\x12B\n" +
+	"\aoptions\x18\x01 \x01(\v2&.appointment.ImportAppointmentsOptionsH\x00R\aoptions\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\apayload\"\xd1\x01\n" +
+	"\x19ImportAppointmentsOptions\x121\n" +
+	"\x06format\x18\x01 \x01(\x0e2\x19.appointment.RecordFormatR\x06format\x12\x1f\n" +
+	"\vcalendar_id\x18\x02 \x01(\tR\n" +
+	"calendarId\x12\x1b\n" +
+	"\ttime_zone\x18\x03 \x01(\tR\btimeZone\x12*\n" +
+	"\x04mode\x18\x04 \x01(\x0e2\x16.appointment.BatchModeR\x04mode\x12\x17\n" +
+	"\adry_run\x18\x05 \x01(\bR\x06dryRun\"r\n" +
+	"\vImportedRow\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12%\n" +
+	"\x0eappointment_id\x18\x02 \x01(\tR\rappointmentId\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\x05R\x04code\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"\xe1\x01\n" +
+	"\x18ImportAppointmentsReport\x12*\n" +
+	"\x04mode\x18\x01 \x01(\x0e2\x16.appointment.BatchModeR\x04mode\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\x12\x1c\n" +
+	"\tcommitted\x18\x03 \x01(\bR\tcommitted\x12\x1c\n" +
+	"\tsucceeded\x18\x04 \x01(\x05R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\x05 \x01(\x05R\x06failed\x12,\n" +
+	"\x04rows\x18\x06 \x03(\v2\x18.appointment.ImportedRowR\x04rows\"\xda\x01\n" +
+	"\tFeedToken\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vcalendar_id\x18\x02 \x01(\tR\n" +
+	"calendarId\x12\x14\n" +
+	"\x05token\x18\x03 \x01(\tR\x05token\x12\x10\n" +
+	"\x03url\x18\x04 \x01(\tR\x03url\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"rotated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\trotatedAt\"9\n" +
+	"\x16CreateFeedTokenRequest\x12\x1f\n" +
+	"\vcalendar_id\x18\x01 \x01(\tR\n" +
+	"calendarId\"(\n" +
+	"\x16RotateFeedTokenRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"(\n" +
+	"\x16RevokeFeedTokenRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"8\n" +
+	"\x15ListFeedTokensRequest\x12\x1f\n" +
+	"\vcalendar_id\x18\x01 \x01(\tR\n" +
+	"calendarId\"Q\n" +
+	"\x16ListFeedTokensResponse\x127\n" +
+	"\vfeed_tokens\x18\x01 \x03(\v2\x16.appointment.FeedTokenR\n" +
+	"feedTokens\"\x86\x02\n" +
+	"\x06APIKey\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06scopes\x18\x03 \x03(\tR\x06scopes\x12\x16\n" +
+	"\x06prefix\x18\x04 \x01(\tR\x06prefix\x12\x10\n" +
+	"\x03key\x18\x05 \x01(\tR\x03key\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x06 \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12<\n" +
+	"\flast_used_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\"A\n" +
+	"\x13CreateAPIKeyRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06scopes\x18\x02 \x03(\tR\x06scopes\"\x14\n" +
+	"\x12ListAPIKeysRequest\"E\n" +
+	"\x13ListAPIKeysResponse\x12.\n" +
+	"\bapi_keys\x18\x01 \x03(\v2\x13.appointment.APIKeyR\aapiKeys\"%\n" +
+	"\x13RevokeAPIKeyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"T\n" +
+	"\x0fConflictDetails\x12A\n" +
+	"\tconflicts\x18\x01 \x03(\v2#.appointment.ConflictingAppointmentR\tconflicts\"\x9a\x01\n" +
+	"\x16ConflictingAppointment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x129\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime*\\\n" +
+	"\x11AppointmentStatus\x12\r\n" +
+	"\tSCHEDULED\x10\x00\x12\r\n" +
+	"\tCONFIRMED\x10\x01\x12\r\n" +
+	"\tCANCELLED\x10\x02\x12\r\n" +
+	"\tCOMPLETED\x10\x03\x12\v\n" +
+	"\aNO_SHOW\x10\x04*(\n" +
+	"\tBatchMode\x12\n" +
+	"\n" +
+	"\x06ATOMIC\x10\x00\x12\x0f\n" +
+	"\vBEST_EFFORT\x10\x01*#\n" +
+	"\fRecordFormat\x12\a\n" +
+	"\x03CSV\x10\x00\x12\n" +
+	"\n" +
+	"\x06NDJSON\x10\x012\x85\x1c\n" +
+	"\x12AppointmentService\x12q\n" +
+	"\x11CreateAppointment\x12%.appointment.CreateAppointmentRequest\x1a\x18.appointment.Appointment\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/appointments\x12m\n" +
+	"\x0eGetAppointment\x12\".appointment.GetAppointmentRequest\x1a\x18.appointment.Appointment\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/appointments/{id}\x12q\n" +
+	"\x11DeleteAppointment\x12%.appointment.DeleteAppointmentRequest\x1a\x16.google.protobuf.Empty\"\x1d\x82\xd3\xe4\x93\x02\x17*\x15/v1/appointments/{id}\x12}\n" +
+	"\x11CancelAppointment\x12%.appointment.CancelAppointmentRequest\x1a\x18.appointment.Appointment\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/appointments/{id}:cancel\x12\x89\x01\n" +
+	"\x17UpdateAppointmentStatus\x12+.appointment.UpdateAppointmentStatusRequest\x1a\x18.appointment.Appointment\"'\x82\xd3\xe4\x93\x02!:\x01*2\x1c/v1/appointments/{id}/status\x12\x97\x01\n" +
+	"\x17BatchCreateAppointments\x12+.appointment.BatchCreateAppointmentsRequest\x1a&.appointment.BatchAppointmentsResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/appointments:batchCreate\x12\x97\x01\n" +
+	"\x17BatchDeleteAppointments\x12+.appointment.BatchDeleteAppointmentsRequest\x1a&.appointment.BatchAppointmentsResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/appointments:batchDelete\x12\x80\x01\n" +
+	"\x12RestoreAppointment\x12&.appointment.RestoreAppointmentRequest\x1a\x18.appointment.Appointment\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/appointments/{id}:restore\x12\x80\x01\n" +
+	"\x17ListDeletedAppointments\x12+.appointment.ListDeletedAppointmentsRequest\x1a%.appointment.ListAppointmentsResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/trash\x12h\n" +
+	"\x10PurgeAppointment\x12$.appointment.PurgeAppointmentRequest\x1a\x16.google.protobuf.Empty\"\x16\x82\xd3\xe4\x93\x02\x10*\x0e/v1/trash/{id}\x12y\n" +
+	"\x10ListAppointments\x12$.appointment.ListAppointmentsRequest\x1a%.appointment.ListAppointmentsResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/appointments\x12n\n" +
+	"\tExportICS\x12\x1d.appointment.ExportICSRequest\x1a\x1e.appointment.ExportICSResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/appointments:exportIcs\x12J\n" +
+	"\tImportICS\x12\x1d.appointment.ImportICSRequest\x1a\x1c.appointment.ImportICSReport(\x01\x12d\n" +
+	"\x12ExportAppointments\x12&.appointment.ExportAppointmentsRequest\x1a$.appointment.ExportAppointmentsChunk0\x01\x12e\n" +
+	"\x12ImportAppointments\x12&.appointment.ImportAppointmentsRequest\x1a%.appointment.ImportAppointmentsReport(\x01\x12\x82\x01\n" +
+	"\x0fCreateFeedToken\x12#.appointment.CreateFeedTokenRequest\x1a\x16.appointment.FeedToken\"2\x82\xd3\xe4\x93\x02,:\x01*\"'/v1/calendars/{calendar_id}/feed-tokens\x12v\n" +
+	"\x0fRotateFeedToken\x12#.appointment.RotateFeedTokenRequest\x1a\x16.appointment.FeedToken\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/feed-tokens/{id}:rotate\x12l\n" +
+	"\x0fRevokeFeedToken\x12#.appointment.RevokeFeedTokenRequest\x1a\x16.google.protobuf.Empty\"\x1c\x82\xd3\xe4\x93\x02\x16*\x14/v1/feed-tokens/{id}\x12\x8a\x01\n" +
+	"\x0eListFeedTokens\x12\".appointment.ListFeedTokensRequest\x1a#.appointment.ListFeedTokensResponse\"/\x82\xd3\xe4\x93\x02)\x12'/v1/calendars/{calendar_id}/feed-tokens\x12X\n" +
+	"\bHoldSlot\x12\x1c.appointment.HoldSlotRequest\x1a\x18.appointment.Appointment\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/holds\x12k\n" +
+	"\vConfirmHold\x12\x1f.appointment.ConfirmHoldRequest\x1a\x18.appointment.Appointment\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/holds/{id}:confirm\x12n\n" +
+	"\rGetStatistics\x12!.appointment.GetStatisticsRequest\x1a\".appointment.GetStatisticsResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/statistics\x12\x8b\x01\n" +
+	"\x13GetCalendarSettings\x12'.appointment.GetCalendarSettingsRequest\x1a\x1d.appointment.CalendarSettings\",\x82\xd3\xe4\x93\x02&\x12$/v1/calendars/{calendar_id}/settings\x12\x94\x01\n" +
+	"\x16UpdateCalendarSettings\x12*.appointment.UpdateCalendarSettingsRequest\x1a\x1d.appointment.CalendarSettings\"/\x82\xd3\xe4\x93\x02):\x01*\x1a$/v1/calendars/{calendar_id}/settings\x12\x95\x01\n" +
+	"\x15GetAppointmentHistory\x12).appointment.GetAppointmentHistoryRequest\x1a*.appointment.GetAppointmentHistoryResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/appointments/{id}/history\x12v\n" +
+	"\x0fListAuditEvents\x12#.appointment.ListAuditEventsRequest\x1a$.appointment.ListAuditEventsResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/audit-events\x12^\n" +
+	"\fCreateAPIKey\x12 .appointment.CreateAPIKeyRequest\x1a\x13.appointment.APIKey\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/api-keys\x12f\n" +
+	"\vListAPIKeys\x12\x1f.appointment.ListAPIKeysRequest\x1a .appointment.ListAPIKeysResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/api-keys\x12c\n" +
+	"\fRevokeAPIKey\x12 .appointment.RevokeAPIKeyRequest\x1a\x16.google.protobuf.Empty\"\x19\x82\xd3\xe4\x93\x02\x13*\x11/v1/api-keys/{id}\x12V\n" +
 	"\x12StreamAppointments\x12\x16.google.protobuf.Empty\x1a&.appointment.AppointmentStreamResponse0\x01B8Z6github.com/pasDamola/schedule-management-system/pkg/pbb\x06proto3"
 
 var (
@@ -640,52 +4349,207 @@ func file_proto_appointment_appointment_proto_rawDescGZIP() []byte {
 	return file_proto_appointment_appointment_proto_rawDescData
 }
 
-var file_proto_appointment_appointment_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_appointment_appointment_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_proto_appointment_appointment_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_proto_appointment_appointment_proto_msgTypes = make([]protoimpl.MessageInfo, 55)
 var file_proto_appointment_appointment_proto_goTypes = []any{
-	(AppointmentStreamResponse_EventType)(0), // 0: appointment.AppointmentStreamResponse.EventType
-	(*Appointment)(nil),                      // 1: appointment.Appointment
-	(*CreateAppointmentRequest)(nil),         // 2: appointment.CreateAppointmentRequest
-	(*GetAppointmentRequest)(nil),            // 3: appointment.GetAppointmentRequest
-	(*UpdateAppointmentRequest)(nil),         // 4: appointment.UpdateAppointmentRequest
-	(*DeleteAppointmentRequest)(nil),         // 5: appointment.DeleteAppointmentRequest
-	(*ListAppointmentsRequest)(nil),          // 6: appointment.ListAppointmentsRequest
-	(*ListAppointmentsResponse)(nil),         // 7: appointment.ListAppointmentsResponse
-	(*AppointmentStreamResponse)(nil),        // 8: appointment.AppointmentStreamResponse
-	(*timestamppb.Timestamp)(nil),            // 9: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                    // 10: google.protobuf.Empty
+	(AppointmentStatus)(0),                   // 0: appointment.AppointmentStatus
+	(BatchMode)(0),                           // 1: appointment.BatchMode
+	(RecordFormat)(0),                        // 2: appointment.RecordFormat
+	(AppointmentStreamResponse_EventType)(0), // 3: appointment.AppointmentStreamResponse.EventType
+	(GetStatisticsRequest_GroupBy)(0),        // 4: appointment.GetStatisticsRequest.GroupBy
+	(ImportedEvent_Outcome)(0),               // 5: appointment.ImportedEvent.Outcome
+	(*Appointment)(nil),                      // 6: appointment.Appointment
+	(*CreateAppointmentRequest)(nil),         // 7: appointment.CreateAppointmentRequest
+	(*HoldSlotRequest)(nil),                  // 8: appointment.HoldSlotRequest
+	(*ConfirmHoldRequest)(nil),               // 9: appointment.ConfirmHoldRequest
+	(*GetAppointmentRequest)(nil),            // 10: appointment.GetAppointmentRequest
+	(*DeleteAppointmentRequest)(nil),         // 11: appointment.DeleteAppointmentRequest
+	(*RestoreAppointmentRequest)(nil),        // 12: appointment.RestoreAppointmentRequest
+	(*ListDeletedAppointmentsRequest)(nil),   // 13: appointment.ListDeletedAppointmentsRequest
+	(*PurgeAppointmentRequest)(nil),          // 14: appointment.PurgeAppointmentRequest
+	(*CancelAppointmentRequest)(nil),         // 15: appointment.CancelAppointmentRequest
+	(*UpdateAppointmentStatusRequest)(nil),   // 16: appointment.UpdateAppointmentStatusRequest
+	(*ListAppointmentsRequest)(nil),          // 17: appointment.ListAppointmentsRequest
+	(*ListAppointmentsResponse)(nil),         // 18: appointment.ListAppointmentsResponse
+	(*AppointmentStreamResponse)(nil),        // 19: appointment.AppointmentStreamResponse
+	(*CalendarSettings)(nil),                 // 20: appointment.CalendarSettings
+	(*GetCalendarSettingsRequest)(nil),       // 21: appointment.GetCalendarSettingsRequest
+	(*UpdateCalendarSettingsRequest)(nil),    // 22: appointment.UpdateCalendarSettingsRequest
+	(*GetStatisticsRequest)(nil),             // 23: appointment.GetStatisticsRequest
+	(*AppointmentStatistics)(nil),            // 24: appointment.AppointmentStatistics
+	(*HourCount)(nil),                        // 25: appointment.HourCount
+	(*GetStatisticsResponse)(nil),            // 26: appointment.GetStatisticsResponse
+	(*AuditEvent)(nil),                       // 27: appointment.AuditEvent
+	(*GetAppointmentHistoryRequest)(nil),     // 28: appointment.GetAppointmentHistoryRequest
+	(*GetAppointmentHistoryResponse)(nil),    // 29: appointment.GetAppointmentHistoryResponse
+	(*ListAuditEventsRequest)(nil),           // 30: appointment.ListAuditEventsRequest
+	(*ListAuditEventsResponse)(nil),          // 31: appointment.ListAuditEventsResponse
+	(*BatchCreateAppointmentsRequest)(nil),   // 32: appointment.BatchCreateAppointmentsRequest
+	(*BatchDeleteAppointmentsRequest)(nil),   // 33: appointment.BatchDeleteAppointmentsRequest
+	(*BatchItemResult)(nil),                  // 34: appointment.BatchItemResult
+	(*BatchAppointmentsResponse)(nil),        // 35: appointment.BatchAppointmentsResponse
+	(*ExportICSRequest)(nil),                 // 36: appointment.ExportICSRequest
+	(*ExportICSResponse)(nil),                // 37: appointment.ExportICSResponse
+	(*ImportICSRequest)(nil),                 // 38: appointment.ImportICSRequest
+	(*ImportICSOptions)(nil),                 // 39: appointment.ImportICSOptions
+	(*ImportedEvent)(nil),                    // 40: appointment.ImportedEvent
+	(*ImportICSReport)(nil),                  // 41: appointment.ImportICSReport
+	(*ExportAppointmentsRequest)(nil),        // 42: appointment.ExportAppointmentsRequest
+	(*ExportAppointmentsChunk)(nil),          // 43: appointment.ExportAppointmentsChunk
+	(*ImportAppointmentsRequest)(nil),        // 44: appointment.ImportAppointmentsRequest
+	(*ImportAppointmentsOptions)(nil),        // 45: appointment.ImportAppointmentsOptions
+	(*ImportedRow)(nil),                      // 46: appointment.ImportedRow
+	(*ImportAppointmentsReport)(nil),         // 47: appointment.ImportAppointmentsReport
+	(*FeedToken)(nil),                        // 48: appointment.FeedToken
+	(*CreateFeedTokenRequest)(nil),           // 49: appointment.CreateFeedTokenRequest
+	(*RotateFeedTokenRequest)(nil),           // 50: appointment.RotateFeedTokenRequest
+	(*RevokeFeedTokenRequest)(nil),           // 51: appointment.RevokeFeedTokenRequest
+	(*ListFeedTokensRequest)(nil),            // 52: appointment.ListFeedTokensRequest
+	(*ListFeedTokensResponse)(nil),           // 53: appointment.ListFeedTokensResponse
+	(*APIKey)(nil),                           // 54: appointment.APIKey
+	(*CreateAPIKeyRequest)(nil),              // 55: appointment.CreateAPIKeyRequest
+	(*ListAPIKeysRequest)(nil),               // 56: appointment.ListAPIKeysRequest
+	(*ListAPIKeysResponse)(nil),              // 57: appointment.ListAPIKeysResponse
+	(*RevokeAPIKeyRequest)(nil),              // 58: appointment.RevokeAPIKeyRequest
+	(*ConflictDetails)(nil),                  // 59: appointment.ConflictDetails
+	(*ConflictingAppointment)(nil),           // 60: appointment.ConflictingAppointment
+	(*timestamppb.Timestamp)(nil),            // 61: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),              // 62: google.protobuf.Duration
+	(*emptypb.Empty)(nil),                    // 63: google.protobuf.Empty
 }
 var file_proto_appointment_appointment_proto_depIdxs = []int32{
-	9,  // 0: appointment.Appointment.start_time:type_name -> google.protobuf.Timestamp
-	9,  // 1: appointment.Appointment.end_time:type_name -> google.protobuf.Timestamp
-	9,  // 2: appointment.Appointment.created_at:type_name -> google.protobuf.Timestamp
-	9,  // 3: appointment.Appointment.updated_at:type_name -> google.protobuf.Timestamp
-	9,  // 4: appointment.CreateAppointmentRequest.start_time:type_name -> google.protobuf.Timestamp
-	9,  // 5: appointment.CreateAppointmentRequest.end_time:type_name -> google.protobuf.Timestamp
-	9,  // 6: appointment.UpdateAppointmentRequest.start_time:type_name -> google.protobuf.Timestamp
-	9,  // 7: appointment.UpdateAppointmentRequest.end_time:type_name -> google.protobuf.Timestamp
-	9,  // 8: appointment.ListAppointmentsRequest.start_date:type_name -> google.protobuf.Timestamp
-	9,  // 9: appointment.ListAppointmentsRequest.end_date:type_name -> google.protobuf.Timestamp
-	1,  // 10: appointment.ListAppointmentsResponse.appointments:type_name -> appointment.Appointment
-	0,  // 11: appointment.AppointmentStreamResponse.event_type:type_name -> appointment.AppointmentStreamResponse.EventType
-	1,  // 12: appointment.AppointmentStreamResponse.appointment:type_name -> appointment.Appointment
-	2,  // 13: appointment.AppointmentService.CreateAppointment:input_type -> appointment.CreateAppointmentRequest
-	3,  // 14: appointment.AppointmentService.GetAppointment:input_type -> appointment.GetAppointmentRequest
-	4,  // 15: appointment.AppointmentService.UpdateAppointment:input_type -> appointment.UpdateAppointmentRequest
-	5,  // 16: appointment.AppointmentService.DeleteAppointment:input_type -> appointment.DeleteAppointmentRequest
-	6,  // 17: appointment.AppointmentService.ListAppointments:input_type -> appointment.ListAppointmentsRequest
-	10, // 18: appointment.AppointmentService.StreamAppointments:input_type -> google.protobuf.Empty
-	1,  // 19: appointment.AppointmentService.CreateAppointment:output_type -> appointment.Appointment
-	1,  // 20: appointment.AppointmentService.GetAppointment:output_type -> appointment.Appointment
-	1,  // 21: appointment.AppointmentService.UpdateAppointment:output_type -> appointment.Appointment
-	10, // 22: appointment.AppointmentService.DeleteAppointment:output_type -> google.protobuf.Empty
-	7,  // 23: appointment.AppointmentService.ListAppointments:output_type -> appointment.ListAppointmentsResponse
-	8,  // 24: appointment.AppointmentService.StreamAppointments:output_type -> appointment.AppointmentStreamResponse
-	19, // [19:25] is the sub-list for method output_type
-	13, // [13:19] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	61, // 0: appointment.Appointment.start_time:type_name -> google.protobuf.Timestamp
+	61, // 1: appointment.Appointment.end_time:type_name -> google.protobuf.Timestamp
+	61, // 2: appointment.Appointment.created_at:type_name -> google.protobuf.Timestamp
+	61, // 3: appointment.Appointment.updated_at:type_name -> google.protobuf.Timestamp
+	61, // 4: appointment.Appointment.hold_expires_at:type_name -> google.protobuf.Timestamp
+	0,  // 5: appointment.Appointment.status:type_name -> appointment.AppointmentStatus
+	61, // 6: appointment.Appointment.cancelled_at:type_name -> google.protobuf.Timestamp
+	61, // 7: appointment.Appointment.deleted_at:type_name -> google.protobuf.Timestamp
+	61, // 8: appointment.CreateAppointmentRequest.start_time:type_name -> google.protobuf.Timestamp
+	61, // 9: appointment.CreateAppointmentRequest.end_time:type_name -> google.protobuf.Timestamp
+	61, // 10: appointment.HoldSlotRequest.start_time:type_name -> google.protobuf.Timestamp
+	61, // 11: appointment.HoldSlotRequest.end_time:type_name -> google.protobuf.Timestamp
+	62, // 12: appointment.HoldSlotRequest.ttl:type_name -> google.protobuf.Duration
+	0,  // 13: appointment.UpdateAppointmentStatusRequest.status:type_name -> appointment.AppointmentStatus
+	61, // 14: appointment.ListAppointmentsRequest.start_date:type_name -> google.protobuf.Timestamp
+	61, // 15: appointment.ListAppointmentsRequest.end_date:type_name -> google.protobuf.Timestamp
+	0,  // 16: appointment.ListAppointmentsRequest.statuses:type_name -> appointment.AppointmentStatus
+	6,  // 17: appointment.ListAppointmentsResponse.appointments:type_name -> appointment.Appointment
+	3,  // 18: appointment.AppointmentStreamResponse.event_type:type_name -> appointment.AppointmentStreamResponse.EventType
+	6,  // 19: appointment.AppointmentStreamResponse.appointment:type_name -> appointment.Appointment
+	61, // 20: appointment.CalendarSettings.updated_at:type_name -> google.protobuf.Timestamp
+	61, // 21: appointment.GetStatisticsRequest.start_date:type_name -> google.protobuf.Timestamp
+	61, // 22: appointment.GetStatisticsRequest.end_date:type_name -> google.protobuf.Timestamp
+	4,  // 23: appointment.GetStatisticsRequest.group_by:type_name -> appointment.GetStatisticsRequest.GroupBy
+	61, // 24: appointment.AppointmentStatistics.period_start:type_name -> google.protobuf.Timestamp
+	61, // 25: appointment.AppointmentStatistics.period_end:type_name -> google.protobuf.Timestamp
+	24, // 26: appointment.GetStatisticsResponse.summary:type_name -> appointment.AppointmentStatistics
+	24, // 27: appointment.GetStatisticsResponse.groups:type_name -> appointment.AppointmentStatistics
+	25, // 28: appointment.GetStatisticsResponse.peak_hours:type_name -> appointment.HourCount
+	61, // 29: appointment.AuditEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	6,  // 30: appointment.AuditEvent.before:type_name -> appointment.Appointment
+	6,  // 31: appointment.AuditEvent.after:type_name -> appointment.Appointment
+	27, // 32: appointment.GetAppointmentHistoryResponse.events:type_name -> appointment.AuditEvent
+	61, // 33: appointment.ListAuditEventsRequest.start_time:type_name -> google.protobuf.Timestamp
+	61, // 34: appointment.ListAuditEventsRequest.end_time:type_name -> google.protobuf.Timestamp
+	27, // 35: appointment.ListAuditEventsResponse.events:type_name -> appointment.AuditEvent
+	7,  // 36: appointment.BatchCreateAppointmentsRequest.appointments:type_name -> appointment.CreateAppointmentRequest
+	1,  // 37: appointment.BatchCreateAppointmentsRequest.mode:type_name -> appointment.BatchMode
+	1,  // 38: appointment.BatchDeleteAppointmentsRequest.mode:type_name -> appointment.BatchMode
+	6,  // 39: appointment.BatchItemResult.appointment:type_name -> appointment.Appointment
+	34, // 40: appointment.BatchAppointmentsResponse.results:type_name -> appointment.BatchItemResult
+	61, // 41: appointment.ExportICSRequest.start_date:type_name -> google.protobuf.Timestamp
+	61, // 42: appointment.ExportICSRequest.end_date:type_name -> google.protobuf.Timestamp
+	0,  // 43: appointment.ExportICSRequest.statuses:type_name -> appointment.AppointmentStatus
+	39, // 44: appointment.ImportICSRequest.options:type_name -> appointment.ImportICSOptions
+	61, // 45: appointment.ImportedEvent.start_time:type_name -> google.protobuf.Timestamp
+	61, // 46: appointment.ImportedEvent.end_time:type_name -> google.protobuf.Timestamp
+	5,  // 47: appointment.ImportedEvent.outcome:type_name -> appointment.ImportedEvent.Outcome
+	40, // 48: appointment.ImportICSReport.events:type_name -> appointment.ImportedEvent
+	2,  // 49: appointment.ExportAppointmentsRequest.format:type_name -> appointment.RecordFormat
+	61, // 50: appointment.ExportAppointmentsRequest.start_date:type_name -> google.protobuf.Timestamp
+	61, // 51: appointment.ExportAppointmentsRequest.end_date:type_name -> google.protobuf.Timestamp
+	0,  // 52: appointment.ExportAppointmentsRequest.statuses:type_name -> appointment.AppointmentStatus
+	45, // 53: appointment.ImportAppointmentsRequest.options:type_name -> appointment.ImportAppointmentsOptions
+	2,  // 54: appointment.ImportAppointmentsOptions.format:type_name -> appointment.RecordFormat
+	1,  // 55: appointment.ImportAppointmentsOptions.mode:type_name -> appointment.BatchMode
+	1,  // 56: appointment.ImportAppointmentsReport.mode:type_name -> appointment.BatchMode
+	46, // 57: appointment.ImportAppointmentsReport.rows:type_name -> appointment.ImportedRow
+	61, // 58: appointment.FeedToken.created_at:type_name -> google.protobuf.Timestamp
+	61, // 59: appointment.FeedToken.rotated_at:type_name -> google.protobuf.Timestamp
+	48, // 60: appointment.ListFeedTokensResponse.feed_tokens:type_name -> appointment.FeedToken
+	61, // 61: appointment.APIKey.created_at:type_name -> google.protobuf.Timestamp
+	61, // 62: appointment.APIKey.last_used_at:type_name -> google.protobuf.Timestamp
+	54, // 63: appointment.ListAPIKeysResponse.api_keys:type_name -> appointment.APIKey
+	60, // 64: appointment.ConflictDetails.conflicts:type_name -> appointment.ConflictingAppointment
+	61, // 65: appointment.ConflictingAppointment.start_time:type_name -> google.protobuf.Timestamp
+	61, // 66: appointment.ConflictingAppointment.end_time:type_name -> google.protobuf.Timestamp
+	7,  // 67: appointment.AppointmentService.CreateAppointment:input_type -> appointment.CreateAppointmentRequest
+	10, // 68: appointment.AppointmentService.GetAppointment:input_type -> appointment.GetAppointmentRequest
+	11, // 69: appointment.AppointmentService.DeleteAppointment:input_type -> appointment.DeleteAppointmentRequest
+	15, // 70: appointment.AppointmentService.CancelAppointment:input_type -> appointment.CancelAppointmentRequest
+	16, // 71: appointment.AppointmentService.UpdateAppointmentStatus:input_type -> appointment.UpdateAppointmentStatusRequest
+	32, // 72: appointment.AppointmentService.BatchCreateAppointments:input_type -> appointment.BatchCreateAppointmentsRequest
+	33, // 73: appointment.AppointmentService.BatchDeleteAppointments:input_type -> appointment.BatchDeleteAppointmentsRequest
+	12, // 74: appointment.AppointmentService.RestoreAppointment:input_type -> appointment.RestoreAppointmentRequest
+	13, // 75: appointment.AppointmentService.ListDeletedAppointments:input_type -> appointment.ListDeletedAppointmentsRequest
+	14, // 76: appointment.AppointmentService.PurgeAppointment:input_type -> appointment.PurgeAppointmentRequest
+	17, // 77: appointment.AppointmentService.ListAppointments:input_type -> appointment.ListAppointmentsRequest
+	36, // 78: appointment.AppointmentService.ExportICS:input_type -> appointment.ExportICSRequest
+	38, // 79: appointment.AppointmentService.ImportICS:input_type -> appointment.ImportICSRequest
+	42, // 80: appointment.AppointmentService.ExportAppointments:input_type -> appointment.ExportAppointmentsRequest
+	44, // 81: appointment.AppointmentService.ImportAppointments:input_type -> appointment.ImportAppointmentsRequest
+	49, // 82: appointment.AppointmentService.CreateFeedToken:input_type -> appointment.CreateFeedTokenRequest
+	50, // 83: appointment.AppointmentService.RotateFeedToken:input_type -> appointment.RotateFeedTokenRequest
+	51, // 84: appointment.AppointmentService.RevokeFeedToken:input_type -> appointment.RevokeFeedTokenRequest
+	52, // 85: appointment.AppointmentService.ListFeedTokens:input_type -> appointment.ListFeedTokensRequest
+	8,  // 86: appointment.AppointmentService.HoldSlot:input_type -> appointment.HoldSlotRequest
+	9,  // 87: appointment.AppointmentService.ConfirmHold:input_type -> appointment.ConfirmHoldRequest
+	23, // 88: appointment.AppointmentService.GetStatistics:input_type -> appointment.GetStatisticsRequest
+	21, // 89: appointment.AppointmentService.GetCalendarSettings:input_type -> appointment.GetCalendarSettingsRequest
+	22, // 90: appointment.AppointmentService.UpdateCalendarSettings:input_type -> appointment.UpdateCalendarSettingsRequest
+	28, // 91: appointment.AppointmentService.GetAppointmentHistory:input_type -> appointment.GetAppointmentHistoryRequest
+	30, // 92: appointment.AppointmentService.ListAuditEvents:input_type -> appointment.ListAuditEventsRequest
+	55, // 93: appointment.AppointmentService.CreateAPIKey:input_type -> appointment.CreateAPIKeyRequest
+	56, // 94: appointment.AppointmentService.ListAPIKeys:input_type -> appointment.ListAPIKeysRequest
+	58, // 95: appointment.AppointmentService.RevokeAPIKey:input_type -> appointment.RevokeAPIKeyRequest
+	63, // 96: appointment.AppointmentService.StreamAppointments:input_type -> google.protobuf.Empty
+	6,  // 97: appointment.AppointmentService.CreateAppointment:output_type -> appointment.Appointment
+	6,  // 98: appointment.AppointmentService.GetAppointment:output_type -> appointment.Appointment
+	63, // 99: appointment.AppointmentService.DeleteAppointment:output_type -> google.protobuf.Empty
+	6,  // 100: appointment.AppointmentService.CancelAppointment:output_type -> appointment.Appointment
+	6,  // 101: appointment.AppointmentService.UpdateAppointmentStatus:output_type -> appointment.Appointment
+	35, // 102: appointment.AppointmentService.BatchCreateAppointments:output_type -> appointment.BatchAppointmentsResponse
+	35, // 103: appointment.AppointmentService.BatchDeleteAppointments:output_type -> appointment.BatchAppointmentsResponse
+	6,  // 104: appointment.AppointmentService.RestoreAppointment:output_type -> appointment.Appointment
+	18, // 105: appointment.AppointmentService.ListDeletedAppointments:output_type -> appointment.ListAppointmentsResponse
+	63, // 106: appointment.AppointmentService.PurgeAppointment:output_type -> google.protobuf.Empty
+	18, // 107: appointment.AppointmentService.ListAppointments:output_type -> appointment.ListAppointmentsResponse
+	37, // 108: appointment.AppointmentService.ExportICS:output_type -> appointment.ExportICSResponse
+	41, // 109: appointment.AppointmentService.ImportICS:output_type -> appointment.ImportICSReport
+	43, // 110: appointment.AppointmentService.ExportAppointments:output_type -> appointment.ExportAppointmentsChunk
+	47, // 111: appointment.AppointmentService.ImportAppointments:output_type -> appointment.ImportAppointmentsReport
+	48, // 112: appointment.AppointmentService.CreateFeedToken:output_type -> appointment.FeedToken
+	48, // 113: appointment.AppointmentService.RotateFeedToken:output_type -> appointment.FeedToken
+	63, // 114: appointment.AppointmentService.RevokeFeedToken:output_type -> google.protobuf.Empty
+	53, // 115: appointment.AppointmentService.ListFeedTokens:output_type -> appointment.ListFeedTokensResponse
+	6,  // 116: appointment.AppointmentService.HoldSlot:output_type -> appointment.Appointment
+	6,  // 117: appointment.AppointmentService.ConfirmHold:output_type -> appointment.Appointment
+	26, // 118: appointment.AppointmentService.GetStatistics:output_type -> appointment.GetStatisticsResponse
+	20, // 119: appointment.AppointmentService.GetCalendarSettings:output_type -> appointment.CalendarSettings
+	20, // 120: appointment.AppointmentService.UpdateCalendarSettings:output_type -> appointment.CalendarSettings
+	29, // 121: appointment.AppointmentService.GetAppointmentHistory:output_type -> appointment.GetAppointmentHistoryResponse
+	31, // 122: appointment.AppointmentService.ListAuditEvents:output_type -> appointment.ListAuditEventsResponse
+	54, // 123: appointment.AppointmentService.CreateAPIKey:output_type -> appointment.APIKey
+	57, // 124: appointment.AppointmentService.ListAPIKeys:output_type -> appointment.ListAPIKeysResponse
+	63, // 125: appointment.AppointmentService.RevokeAPIKey:output_type -> google.protobuf.Empty
+	19, // 126: appointment.AppointmentService.StreamAppointments:output_type -> appointment.AppointmentStreamResponse
+	97, // [97:127] is the sub-list for method output_type
+	67, // [67:97] is the sub-list for method input_type
+	67, // [67:67] is the sub-list for extension type_name
+	67, // [67:67] is the sub-list for extension extendee
+	0,  // [0:67] is the sub-list for field type_name
 }
 
 func init() { file_proto_appointment_appointment_proto_init() }
@@ -693,13 +4557,21 @@ func file_proto_appointment_appointment_proto_init() {
 	if File_proto_appointment_appointment_proto != nil {
 		return
 	}
+	file_proto_appointment_appointment_proto_msgTypes[32].OneofWrappers = []any{
+		(*ImportICSRequest_Options)(nil),
+		(*ImportICSRequest_Chunk)(nil),
+	}
+	file_proto_appointment_appointment_proto_msgTypes[38].OneofWrappers = []any{
+		(*ImportAppointmentsRequest_Options)(nil),
+		(*ImportAppointmentsRequest_Chunk)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_appointment_appointment_proto_rawDesc), len(file_proto_appointment_appointment_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   8,
+			NumEnums:      6,
+			NumMessages:   55,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,27 +20,103 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AppointmentService_CreateAppointment_FullMethodName  = "/appointment.AppointmentService/CreateAppointment"
-	AppointmentService_GetAppointment_FullMethodName     = "/appointment.AppointmentService/GetAppointment"
-	AppointmentService_UpdateAppointment_FullMethodName  = "/appointment.AppointmentService/UpdateAppointment"
-	AppointmentService_DeleteAppointment_FullMethodName  = "/appointment.AppointmentService/DeleteAppointment"
-	AppointmentService_ListAppointments_FullMethodName   = "/appointment.AppointmentService/ListAppointments"
-	AppointmentService_StreamAppointments_FullMethodName = "/appointment.AppointmentService/StreamAppointments"
+	AppointmentService_CreateAppointment_FullMethodName       = "/appointment.AppointmentService/CreateAppointment"
+	AppointmentService_GetAppointment_FullMethodName          = "/appointment.AppointmentService/GetAppointment"
+	AppointmentService_DeleteAppointment_FullMethodName       = "/appointment.AppointmentService/DeleteAppointment"
+	AppointmentService_CancelAppointment_FullMethodName       = "/appointment.AppointmentService/CancelAppointment"
+	AppointmentService_UpdateAppointmentStatus_FullMethodName = "/appointment.AppointmentService/UpdateAppointmentStatus"
+	AppointmentService_BatchCreateAppointments_FullMethodName = "/appointment.AppointmentService/BatchCreateAppointments"
+	AppointmentService_BatchDeleteAppointments_FullMethodName = "/appointment.AppointmentService/BatchDeleteAppointments"
+	AppointmentService_RestoreAppointment_FullMethodName      = "/appointment.AppointmentService/RestoreAppointment"
+	AppointmentService_ListDeletedAppointments_FullMethodName = "/appointment.AppointmentService/ListDeletedAppointments"
+	AppointmentService_PurgeAppointment_FullMethodName        = "/appointment.AppointmentService/PurgeAppointment"
+	AppointmentService_ListAppointments_FullMethodName        = "/appointment.AppointmentService/ListAppointments"
+	AppointmentService_ExportICS_FullMethodName               = "/appointment.AppointmentService/ExportICS"
+	AppointmentService_ImportICS_FullMethodName               = "/appointment.AppointmentService/ImportICS"
+	AppointmentService_ExportAppointments_FullMethodName      = "/appointment.AppointmentService/ExportAppointments"
+	AppointmentService_ImportAppointments_FullMethodName      = "/appointment.AppointmentService/ImportAppointments"
+	AppointmentService_CreateFeedToken_FullMethodName         = "/appointment.AppointmentService/CreateFeedToken"
+	AppointmentService_RotateFeedToken_FullMethodName         = "/appointment.AppointmentService/RotateFeedToken"
+	AppointmentService_RevokeFeedToken_FullMethodName         = "/appointment.AppointmentService/RevokeFeedToken"
+	AppointmentService_ListFeedTokens_FullMethodName          = "/appointment.AppointmentService/ListFeedTokens"
+	AppointmentService_HoldSlot_FullMethodName                = "/appointment.AppointmentService/HoldSlot"
+	AppointmentService_ConfirmHold_FullMethodName             = "/appointment.AppointmentService/ConfirmHold"
+	AppointmentService_GetStatistics_FullMethodName           = "/appointment.AppointmentService/GetStatistics"
+	AppointmentService_GetCalendarSettings_FullMethodName     = "/appointment.AppointmentService/GetCalendarSettings"
+	AppointmentService_UpdateCalendarSettings_FullMethodName  = "/appointment.AppointmentService/UpdateCalendarSettings"
+	AppointmentService_GetAppointmentHistory_FullMethodName   = "/appointment.AppointmentService/GetAppointmentHistory"
+	AppointmentService_ListAuditEvents_FullMethodName         = "/appointment.AppointmentService/ListAuditEvents"
+	AppointmentService_CreateAPIKey_FullMethodName            = "/appointment.AppointmentService/CreateAPIKey"
+	AppointmentService_ListAPIKeys_FullMethodName             = "/appointment.AppointmentService/ListAPIKeys"
+	AppointmentService_RevokeAPIKey_FullMethodName            = "/appointment.AppointmentService/RevokeAPIKey"
+	AppointmentService_StreamAppointments_FullMethodName      = "/appointment.AppointmentService/StreamAppointments"
 )
 
 // AppointmentServiceClient is the client API for AppointmentService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 //
-// AppointmentService definition
+// AppointmentService schedules appointments without overlaps and publishes their changes
 type AppointmentServiceClient interface {
-	// CRUD operations
+	// Creates an appointment, rejecting it if the slot conflicts with another one
 	CreateAppointment(ctx context.Context, in *CreateAppointmentRequest, opts ...grpc.CallOption) (*Appointment, error)
+	// Returns a live appointment by ID
 	GetAppointment(ctx context.Context, in *GetAppointmentRequest, opts ...grpc.CallOption) (*Appointment, error)
-	UpdateAppointment(ctx context.Context, in *UpdateAppointmentRequest, opts ...grpc.CallOption) (*Appointment, error)
+	// Moves an appointment to the trash
 	DeleteAppointment(ctx context.Context, in *DeleteAppointmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Cancels an appointment, freeing its slot, with an optional reason
+	CancelAppointment(ctx context.Context, in *CancelAppointmentRequest, opts ...grpc.CallOption) (*Appointment, error)
+	// Moves an appointment to another status along the allowed transitions
+	UpdateAppointmentStatus(ctx context.Context, in *UpdateAppointmentStatusRequest, opts ...grpc.CallOption) (*Appointment, error)
+	// Creates up to 500 appointments, atomically or best effort
+	BatchCreateAppointments(ctx context.Context, in *BatchCreateAppointmentsRequest, opts ...grpc.CallOption) (*BatchAppointmentsResponse, error)
+	// Moves up to 500 appointments to the trash, atomically or best effort
+	BatchDeleteAppointments(ctx context.Context, in *BatchDeleteAppointmentsRequest, opts ...grpc.CallOption) (*BatchAppointmentsResponse, error)
+	// Brings an appointment back from the trash if its slot is still free
+	RestoreAppointment(ctx context.Context, in *RestoreAppointmentRequest, opts ...grpc.CallOption) (*Appointment, error)
+	// Lists the appointments in the trash
+	ListDeletedAppointments(ctx context.Context, in *ListDeletedAppointmentsRequest, opts ...grpc.CallOption) (*ListAppointmentsResponse, error)
+	// Permanently deletes an appointment from the trash
+	PurgeAppointment(ctx context.Context, in *PurgeAppointmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Lists live appointments matching the filters, a page at a time
 	ListAppointments(ctx context.Context, in *ListAppointmentsRequest, opts ...grpc.CallOption) (*ListAppointmentsResponse, error)
-	// Real-time streaming
+	// Exports the appointments matching the filters as an iCalendar file
+	ExportICS(ctx context.Context, in *ExportICSRequest, opts ...grpc.CallOption) (*ExportICSResponse, error)
+	// Imports the events of an iCalendar file
+	ImportICS(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportICSRequest, ImportICSReport], error)
+	// Streams the appointments matching the filters as CSV or NDJSON
+	ExportAppointments(ctx context.Context, in *ExportAppointmentsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportAppointmentsChunk], error)
+	// Imports appointments from a CSV or NDJSON file with per-row results
+	ImportAppointments(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportAppointmentsRequest, ImportAppointmentsReport], error)
+	// Publishes a calendar at a new secret feed URL
+	CreateFeedToken(ctx context.Context, in *CreateFeedTokenRequest, opts ...grpc.CallOption) (*FeedToken, error)
+	// Replaces the secret of a feed URL
+	RotateFeedToken(ctx context.Context, in *RotateFeedTokenRequest, opts ...grpc.CallOption) (*FeedToken, error)
+	// Stops a feed URL working
+	RevokeFeedToken(ctx context.Context, in *RevokeFeedTokenRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Lists the feed tokens of a calendar, without their secrets
+	ListFeedTokens(ctx context.Context, in *ListFeedTokensRequest, opts ...grpc.CallOption) (*ListFeedTokensResponse, error)
+	// Reserves a slot for a limited time while a booking is completed
+	HoldSlot(ctx context.Context, in *HoldSlotRequest, opts ...grpc.CallOption) (*Appointment, error)
+	// Turns a tentative hold into a regular appointment
+	ConfirmHold(ctx context.Context, in *ConfirmHoldRequest, opts ...grpc.CallOption) (*Appointment, error)
+	// Reports counts, booked hours and utilization for a period
+	GetStatistics(ctx context.Context, in *GetStatisticsRequest, opts ...grpc.CallOption) (*GetStatisticsResponse, error)
+	// Returns the settings of a calendar
+	GetCalendarSettings(ctx context.Context, in *GetCalendarSettingsRequest, opts ...grpc.CallOption) (*CalendarSettings, error)
+	// Changes the settings of a calendar
+	UpdateCalendarSettings(ctx context.Context, in *UpdateCalendarSettingsRequest, opts ...grpc.CallOption) (*CalendarSettings, error)
+	// Returns every recorded change of an appointment
+	GetAppointmentHistory(ctx context.Context, in *GetAppointmentHistoryRequest, opts ...grpc.CallOption) (*GetAppointmentHistoryResponse, error)
+	// Lists recorded changes across appointments
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
+	// Issues an API key for a service integration; the key itself is only returned here
+	CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*APIKey, error)
+	// Lists the active API keys of the caller's tenant, without their secrets
+	ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysResponse, error)
+	// Stops an API key working
+	RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Streams appointment changes as they happen
 	StreamAppointments(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AppointmentStreamResponse], error)
 }
 
@@ -72,20 +148,80 @@ func (c *appointmentServiceClient) GetAppointment(ctx context.Context, in *GetAp
 	return out, nil
 }
 
-func (c *appointmentServiceClient) UpdateAppointment(ctx context.Context, in *UpdateAppointmentRequest, opts ...grpc.CallOption) (*Appointment, error) {
+func (c *appointmentServiceClient) DeleteAppointment(ctx context.Context, in *DeleteAppointmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AppointmentService_DeleteAppointment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) CancelAppointment(ctx context.Context, in *CancelAppointmentRequest, opts ...grpc.CallOption) (*Appointment, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Appointment)
-	err := c.cc.Invoke(ctx, AppointmentService_UpdateAppointment_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, AppointmentService_CancelAppointment_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *appointmentServiceClient) DeleteAppointment(ctx context.Context, in *DeleteAppointmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *appointmentServiceClient) UpdateAppointmentStatus(ctx context.Context, in *UpdateAppointmentStatusRequest, opts ...grpc.CallOption) (*Appointment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Appointment)
+	err := c.cc.Invoke(ctx, AppointmentService_UpdateAppointmentStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) BatchCreateAppointments(ctx context.Context, in *BatchCreateAppointmentsRequest, opts ...grpc.CallOption) (*BatchAppointmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchAppointmentsResponse)
+	err := c.cc.Invoke(ctx, AppointmentService_BatchCreateAppointments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) BatchDeleteAppointments(ctx context.Context, in *BatchDeleteAppointmentsRequest, opts ...grpc.CallOption) (*BatchAppointmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchAppointmentsResponse)
+	err := c.cc.Invoke(ctx, AppointmentService_BatchDeleteAppointments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) RestoreAppointment(ctx context.Context, in *RestoreAppointmentRequest, opts ...grpc.CallOption) (*Appointment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Appointment)
+	err := c.cc.Invoke(ctx, AppointmentService_RestoreAppointment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) ListDeletedAppointments(ctx context.Context, in *ListDeletedAppointmentsRequest, opts ...grpc.CallOption) (*ListAppointmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAppointmentsResponse)
+	err := c.cc.Invoke(ctx, AppointmentService_ListDeletedAppointments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) PurgeAppointment(ctx context.Context, in *PurgeAppointmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, AppointmentService_DeleteAppointment_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, AppointmentService_PurgeAppointment_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -102,9 +238,204 @@ func (c *appointmentServiceClient) ListAppointments(ctx context.Context, in *Lis
 	return out, nil
 }
 
+func (c *appointmentServiceClient) ExportICS(ctx context.Context, in *ExportICSRequest, opts ...grpc.CallOption) (*ExportICSResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportICSResponse)
+	err := c.cc.Invoke(ctx, AppointmentService_ExportICS_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) ImportICS(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportICSRequest, ImportICSReport], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AppointmentService_ServiceDesc.Streams[0], AppointmentService_ImportICS_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportICSRequest, ImportICSReport]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AppointmentService_ImportICSClient = grpc.ClientStreamingClient[ImportICSRequest, ImportICSReport]
+
+func (c *appointmentServiceClient) ExportAppointments(ctx context.Context, in *ExportAppointmentsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportAppointmentsChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AppointmentService_ServiceDesc.Streams[1], AppointmentService_ExportAppointments_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportAppointmentsRequest, ExportAppointmentsChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AppointmentService_ExportAppointmentsClient = grpc.ServerStreamingClient[ExportAppointmentsChunk]
+
+func (c *appointmentServiceClient) ImportAppointments(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportAppointmentsRequest, ImportAppointmentsReport], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AppointmentService_ServiceDesc.Streams[2], AppointmentService_ImportAppointments_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportAppointmentsRequest, ImportAppointmentsReport]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AppointmentService_ImportAppointmentsClient = grpc.ClientStreamingClient[ImportAppointmentsRequest, ImportAppointmentsReport]
+
+func (c *appointmentServiceClient) CreateFeedToken(ctx context.Context, in *CreateFeedTokenRequest, opts ...grpc.CallOption) (*FeedToken, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeedToken)
+	err := c.cc.Invoke(ctx, AppointmentService_CreateFeedToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) RotateFeedToken(ctx context.Context, in *RotateFeedTokenRequest, opts ...grpc.CallOption) (*FeedToken, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeedToken)
+	err := c.cc.Invoke(ctx, AppointmentService_RotateFeedToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) RevokeFeedToken(ctx context.Context, in *RevokeFeedTokenRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AppointmentService_RevokeFeedToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) ListFeedTokens(ctx context.Context, in *ListFeedTokensRequest, opts ...grpc.CallOption) (*ListFeedTokensResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFeedTokensResponse)
+	err := c.cc.Invoke(ctx, AppointmentService_ListFeedTokens_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) HoldSlot(ctx context.Context, in *HoldSlotRequest, opts ...grpc.CallOption) (*Appointment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Appointment)
+	err := c.cc.Invoke(ctx, AppointmentService_HoldSlot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) ConfirmHold(ctx context.Context, in *ConfirmHoldRequest, opts ...grpc.CallOption) (*Appointment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Appointment)
+	err := c.cc.Invoke(ctx, AppointmentService_ConfirmHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) GetStatistics(ctx context.Context, in *GetStatisticsRequest, opts ...grpc.CallOption) (*GetStatisticsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStatisticsResponse)
+	err := c.cc.Invoke(ctx, AppointmentService_GetStatistics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) GetCalendarSettings(ctx context.Context, in *GetCalendarSettingsRequest, opts ...grpc.CallOption) (*CalendarSettings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalendarSettings)
+	err := c.cc.Invoke(ctx, AppointmentService_GetCalendarSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) UpdateCalendarSettings(ctx context.Context, in *UpdateCalendarSettingsRequest, opts ...grpc.CallOption) (*CalendarSettings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalendarSettings)
+	err := c.cc.Invoke(ctx, AppointmentService_UpdateCalendarSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) GetAppointmentHistory(ctx context.Context, in *GetAppointmentHistoryRequest, opts ...grpc.CallOption) (*GetAppointmentHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAppointmentHistoryResponse)
+	err := c.cc.Invoke(ctx, AppointmentService_GetAppointmentHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, AppointmentService_ListAuditEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*APIKey, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(APIKey)
+	err := c.cc.Invoke(ctx, AppointmentService_CreateAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAPIKeysResponse)
+	err := c.cc.Invoke(ctx, AppointmentService_ListAPIKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AppointmentService_RevokeAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *appointmentServiceClient) StreamAppointments(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AppointmentStreamResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &AppointmentService_ServiceDesc.Streams[0], AppointmentService_StreamAppointments_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &AppointmentService_ServiceDesc.Streams[3], AppointmentService_StreamAppointments_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -125,15 +456,67 @@ type AppointmentService_StreamAppointmentsClient = grpc.ServerStreamingClient[Ap
 // All implementations must embed UnimplementedAppointmentServiceServer
 // for forward compatibility.
 //
-// AppointmentService definition
+// AppointmentService schedules appointments without overlaps and publishes their changes
 type AppointmentServiceServer interface {
-	// CRUD operations
+	// Creates an appointment, rejecting it if the slot conflicts with another one
 	CreateAppointment(context.Context, *CreateAppointmentRequest) (*Appointment, error)
+	// Returns a live appointment by ID
 	GetAppointment(context.Context, *GetAppointmentRequest) (*Appointment, error)
-	UpdateAppointment(context.Context, *UpdateAppointmentRequest) (*Appointment, error)
+	// Moves an appointment to the trash
 	DeleteAppointment(context.Context, *DeleteAppointmentRequest) (*emptypb.Empty, error)
+	// Cancels an appointment, freeing its slot, with an optional reason
+	CancelAppointment(context.Context, *CancelAppointmentRequest) (*Appointment, error)
+	// Moves an appointment to another status along the allowed transitions
+	UpdateAppointmentStatus(context.Context, *UpdateAppointmentStatusRequest) (*Appointment, error)
+	// Creates up to 500 appointments, atomically or best effort
+	BatchCreateAppointments(context.Context, *BatchCreateAppointmentsRequest) (*BatchAppointmentsResponse, error)
+	// Moves up to 500 appointments to the trash, atomically or best effort
+	BatchDeleteAppointments(context.Context, *BatchDeleteAppointmentsRequest) (*BatchAppointmentsResponse, error)
+	// Brings an appointment back from the trash if its slot is still free
+	RestoreAppointment(context.Context, *RestoreAppointmentRequest) (*Appointment, error)
+	// Lists the appointments in the trash
+	ListDeletedAppointments(context.Context, *ListDeletedAppointmentsRequest) (*ListAppointmentsResponse, error)
+	// Permanently deletes an appointment from the trash
+	PurgeAppointment(context.Context, *PurgeAppointmentRequest) (*emptypb.Empty, error)
+	// Lists live appointments matching the filters, a page at a time
 	ListAppointments(context.Context, *ListAppointmentsRequest) (*ListAppointmentsResponse, error)
-	// Real-time streaming
+	// Exports the appointments matching the filters as an iCalendar file
+	ExportICS(context.Context, *ExportICSRequest) (*ExportICSResponse, error)
+	// Imports the events of an iCalendar file
+	ImportICS(grpc.ClientStreamingServer[ImportICSRequest, ImportICSReport]) error
+	// Streams the appointments matching the filters as CSV or NDJSON
+	ExportAppointments(*ExportAppointmentsRequest, grpc.ServerStreamingServer[ExportAppointmentsChunk]) error
+	// Imports appointments from a CSV or NDJSON file with per-row results
+	ImportAppointments(grpc.ClientStreamingServer[ImportAppointmentsRequest, ImportAppointmentsReport]) error
+	// Publishes a calendar at a new secret feed URL
+	CreateFeedToken(context.Context, *CreateFeedTokenRequest) (*FeedToken, error)
+	// Replaces the secret of a feed URL
+	RotateFeedToken(context.Context, *RotateFeedTokenRequest) (*FeedToken, error)
+	// Stops a feed URL working
+	RevokeFeedToken(context.Context, *RevokeFeedTokenRequest) (*emptypb.Empty, error)
+	// Lists the feed tokens of a calendar, without their secrets
+	ListFeedTokens(context.Context, *ListFeedTokensRequest) (*ListFeedTokensResponse, error)
+	// Reserves a slot for a limited time while a booking is completed
+	HoldSlot(context.Context, *HoldSlotRequest) (*Appointment, error)
+	// Turns a tentative hold into a regular appointment
+	ConfirmHold(context.Context, *ConfirmHoldRequest) (*Appointment, error)
+	// Reports counts, booked hours and utilization for a period
+	GetStatistics(context.Context, *GetStatisticsRequest) (*GetStatisticsResponse, error)
+	// Returns the settings of a calendar
+	GetCalendarSettings(context.Context, *GetCalendarSettingsRequest) (*CalendarSettings, error)
+	// Changes the settings of a calendar
+	UpdateCalendarSettings(context.Context, *UpdateCalendarSettingsRequest) (*CalendarSettings, error)
+	// Returns every recorded change of an appointment
+	GetAppointmentHistory(context.Context, *GetAppointmentHistoryRequest) (*GetAppointmentHistoryResponse, error)
+	// Lists recorded changes across appointments
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+	// Issues an API key for a service integration; the key itself is only returned here
+	CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*APIKey, error)
+	// Lists the active API keys of the caller's tenant, without their secrets
+	ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysResponse, error)
+	// Stops an API key working
+	RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*emptypb.Empty, error)
+	// Streams appointment changes as they happen
 	StreamAppointments(*emptypb.Empty, grpc.ServerStreamingServer[AppointmentStreamResponse]) error
 	mustEmbedUnimplementedAppointmentServiceServer()
 }
@@ -151,15 +534,87 @@ func (UnimplementedAppointmentServiceServer) CreateAppointment(context.Context,
 func (UnimplementedAppointmentServiceServer) GetAppointment(context.Context, *GetAppointmentRequest) (*Appointment, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAppointment not implemented")
 }
-func (UnimplementedAppointmentServiceServer) UpdateAppointment(context.Context, *UpdateAppointmentRequest) (*Appointment, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateAppointment not implemented")
-}
 func (UnimplementedAppointmentServiceServer) DeleteAppointment(context.Context, *DeleteAppointmentRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteAppointment not implemented")
 }
+func (UnimplementedAppointmentServiceServer) CancelAppointment(context.Context, *CancelAppointmentRequest) (*Appointment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAppointment not implemented")
+}
+func (UnimplementedAppointmentServiceServer) UpdateAppointmentStatus(context.Context, *UpdateAppointmentStatusRequest) (*Appointment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAppointmentStatus not implemented")
+}
+func (UnimplementedAppointmentServiceServer) BatchCreateAppointments(context.Context, *BatchCreateAppointmentsRequest) (*BatchAppointmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateAppointments not implemented")
+}
+func (UnimplementedAppointmentServiceServer) BatchDeleteAppointments(context.Context, *BatchDeleteAppointmentsRequest) (*BatchAppointmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteAppointments not implemented")
+}
+func (UnimplementedAppointmentServiceServer) RestoreAppointment(context.Context, *RestoreAppointmentRequest) (*Appointment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreAppointment not implemented")
+}
+func (UnimplementedAppointmentServiceServer) ListDeletedAppointments(context.Context, *ListDeletedAppointmentsRequest) (*ListAppointmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedAppointments not implemented")
+}
+func (UnimplementedAppointmentServiceServer) PurgeAppointment(context.Context, *PurgeAppointmentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeAppointment not implemented")
+}
 func (UnimplementedAppointmentServiceServer) ListAppointments(context.Context, *ListAppointmentsRequest) (*ListAppointmentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAppointments not implemented")
 }
+func (UnimplementedAppointmentServiceServer) ExportICS(context.Context, *ExportICSRequest) (*ExportICSResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportICS not implemented")
+}
+func (UnimplementedAppointmentServiceServer) ImportICS(grpc.ClientStreamingServer[ImportICSRequest, ImportICSReport]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportICS not implemented")
+}
+func (UnimplementedAppointmentServiceServer) ExportAppointments(*ExportAppointmentsRequest, grpc.ServerStreamingServer[ExportAppointmentsChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportAppointments not implemented")
+}
+func (UnimplementedAppointmentServiceServer) ImportAppointments(grpc.ClientStreamingServer[ImportAppointmentsRequest, ImportAppointmentsReport]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportAppointments not implemented")
+}
+func (UnimplementedAppointmentServiceServer) CreateFeedToken(context.Context, *CreateFeedTokenRequest) (*FeedToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFeedToken not implemented")
+}
+func (UnimplementedAppointmentServiceServer) RotateFeedToken(context.Context, *RotateFeedTokenRequest) (*FeedToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateFeedToken not implemented")
+}
+func (UnimplementedAppointmentServiceServer) RevokeFeedToken(context.Context, *RevokeFeedTokenRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeFeedToken not implemented")
+}
+func (UnimplementedAppointmentServiceServer) ListFeedTokens(context.Context, *ListFeedTokensRequest) (*ListFeedTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFeedTokens not implemented")
+}
+func (UnimplementedAppointmentServiceServer) HoldSlot(context.Context, *HoldSlotRequest) (*Appointment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HoldSlot not implemented")
+}
+func (UnimplementedAppointmentServiceServer) ConfirmHold(context.Context, *ConfirmHoldRequest) (*Appointment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmHold not implemented")
+}
+func (UnimplementedAppointmentServiceServer) GetStatistics(context.Context, *GetStatisticsRequest) (*GetStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatistics not implemented")
+}
+func (UnimplementedAppointmentServiceServer) GetCalendarSettings(context.Context, *GetCalendarSettingsRequest) (*CalendarSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCalendarSettings not implemented")
+}
+func (UnimplementedAppointmentServiceServer) UpdateCalendarSettings(context.Context, *UpdateCalendarSettingsRequest) (*CalendarSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCalendarSettings not implemented")
+}
+func (UnimplementedAppointmentServiceServer) GetAppointmentHistory(context.Context, *GetAppointmentHistoryRequest) (*GetAppointmentHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAppointmentHistory not implemented")
+}
+func (UnimplementedAppointmentServiceServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (UnimplementedAppointmentServiceServer) CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*APIKey, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIKey not implemented")
+}
+func (UnimplementedAppointmentServiceServer) ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAPIKeys not implemented")
+}
+func (UnimplementedAppointmentServiceServer) RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAPIKey not implemented")
+}
 func (UnimplementedAppointmentServiceServer) StreamAppointments(*emptypb.Empty, grpc.ServerStreamingServer[AppointmentStreamResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method StreamAppointments not implemented")
 }
@@ -220,76 +675,479 @@ func _AppointmentService_GetAppointment_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AppointmentService_UpdateAppointment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateAppointmentRequest)
+func _AppointmentService_DeleteAppointment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAppointmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AppointmentServiceServer).UpdateAppointment(ctx, in)
+		return srv.(AppointmentServiceServer).DeleteAppointment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AppointmentService_UpdateAppointment_FullMethodName,
+		FullMethod: AppointmentService_DeleteAppointment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppointmentServiceServer).UpdateAppointment(ctx, req.(*UpdateAppointmentRequest))
+		return srv.(AppointmentServiceServer).DeleteAppointment(ctx, req.(*DeleteAppointmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AppointmentService_DeleteAppointment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteAppointmentRequest)
+func _AppointmentService_CancelAppointment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelAppointmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AppointmentServiceServer).DeleteAppointment(ctx, in)
+		return srv.(AppointmentServiceServer).CancelAppointment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AppointmentService_DeleteAppointment_FullMethodName,
+		FullMethod: AppointmentService_CancelAppointment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppointmentServiceServer).DeleteAppointment(ctx, req.(*DeleteAppointmentRequest))
+		return srv.(AppointmentServiceServer).CancelAppointment(ctx, req.(*CancelAppointmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AppointmentService_ListAppointments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAppointmentsRequest)
+func _AppointmentService_UpdateAppointmentStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAppointmentStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AppointmentServiceServer).ListAppointments(ctx, in)
+		return srv.(AppointmentServiceServer).UpdateAppointmentStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AppointmentService_ListAppointments_FullMethodName,
+		FullMethod: AppointmentService_UpdateAppointmentStatus_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppointmentServiceServer).ListAppointments(ctx, req.(*ListAppointmentsRequest))
+		return srv.(AppointmentServiceServer).UpdateAppointmentStatus(ctx, req.(*UpdateAppointmentStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AppointmentService_StreamAppointments_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(emptypb.Empty)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _AppointmentService_BatchCreateAppointments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateAppointmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(AppointmentServiceServer).StreamAppointments(m, &grpc.GenericServerStream[emptypb.Empty, AppointmentStreamResponse]{ServerStream: stream})
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).BatchCreateAppointments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_BatchCreateAppointments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).BatchCreateAppointments(ctx, req.(*BatchCreateAppointmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type AppointmentService_StreamAppointmentsServer = grpc.ServerStreamingServer[AppointmentStreamResponse]
-
-// AppointmentService_ServiceDesc is the grpc.ServiceDesc for AppointmentService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var AppointmentService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "appointment.AppointmentService",
+func _AppointmentService_BatchDeleteAppointments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteAppointmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).BatchDeleteAppointments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_BatchDeleteAppointments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).BatchDeleteAppointments(ctx, req.(*BatchDeleteAppointmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentService_RestoreAppointment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreAppointmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).RestoreAppointment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_RestoreAppointment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).RestoreAppointment(ctx, req.(*RestoreAppointmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentService_ListDeletedAppointments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedAppointmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).ListDeletedAppointments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_ListDeletedAppointments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).ListDeletedAppointments(ctx, req.(*ListDeletedAppointmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentService_PurgeAppointment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeAppointmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).PurgeAppointment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_PurgeAppointment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).PurgeAppointment(ctx, req.(*PurgeAppointmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentService_ListAppointments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAppointmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).ListAppointments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_ListAppointments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).ListAppointments(ctx, req.(*ListAppointmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentService_ExportICS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportICSRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).ExportICS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_ExportICS_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).ExportICS(ctx, req.(*ExportICSRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentService_ImportICS_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AppointmentServiceServer).ImportICS(&grpc.GenericServerStream[ImportICSRequest, ImportICSReport]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AppointmentService_ImportICSServer = grpc.ClientStreamingServer[ImportICSRequest, ImportICSReport]
+
+func _AppointmentService_ExportAppointments_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportAppointmentsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AppointmentServiceServer).ExportAppointments(m, &grpc.GenericServerStream[ExportAppointmentsRequest, ExportAppointmentsChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AppointmentService_ExportAppointmentsServer = grpc.ServerStreamingServer[ExportAppointmentsChunk]
+
+func _AppointmentService_ImportAppointments_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AppointmentServiceServer).ImportAppointments(&grpc.GenericServerStream[ImportAppointmentsRequest, ImportAppointmentsReport]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AppointmentService_ImportAppointmentsServer = grpc.ClientStreamingServer[ImportAppointmentsRequest, ImportAppointmentsReport]
+
+func _AppointmentService_CreateFeedToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFeedTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).CreateFeedToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_CreateFeedToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).CreateFeedToken(ctx, req.(*CreateFeedTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentService_RotateFeedToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateFeedTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).RotateFeedToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_RotateFeedToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).RotateFeedToken(ctx, req.(*RotateFeedTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentService_RevokeFeedToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeFeedTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).RevokeFeedToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_RevokeFeedToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).RevokeFeedToken(ctx, req.(*RevokeFeedTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentService_ListFeedTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFeedTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).ListFeedTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_ListFeedTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).ListFeedTokens(ctx, req.(*ListFeedTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentService_HoldSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HoldSlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).HoldSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_HoldSlot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).HoldSlot(ctx, req.(*HoldSlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentService_ConfirmHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).ConfirmHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_ConfirmHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).ConfirmHold(ctx, req.(*ConfirmHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentService_GetStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).GetStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_GetStatistics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).GetStatistics(ctx, req.(*GetStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentService_GetCalendarSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCalendarSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).GetCalendarSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_GetCalendarSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).GetCalendarSettings(ctx, req.(*GetCalendarSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentService_UpdateCalendarSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCalendarSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).UpdateCalendarSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_UpdateCalendarSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).UpdateCalendarSettings(ctx, req.(*UpdateCalendarSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentService_GetAppointmentHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppointmentHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).GetAppointmentHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_GetAppointmentHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).GetAppointmentHistory(ctx, req.(*GetAppointmentHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentService_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_ListAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentService_CreateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).CreateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_CreateAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).CreateAPIKey(ctx, req.(*CreateAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentService_ListAPIKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAPIKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).ListAPIKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_ListAPIKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).ListAPIKeys(ctx, req.(*ListAPIKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentService_RevokeAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).RevokeAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_RevokeAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).RevokeAPIKey(ctx, req.(*RevokeAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentService_StreamAppointments_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AppointmentServiceServer).StreamAppointments(m, &grpc.GenericServerStream[emptypb.Empty, AppointmentStreamResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AppointmentService_StreamAppointmentsServer = grpc.ServerStreamingServer[AppointmentStreamResponse]
+
+// AppointmentService_ServiceDesc is the grpc.ServiceDesc for AppointmentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AppointmentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "appointment.AppointmentService",
 	HandlerType: (*AppointmentServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
@@ -300,20 +1158,119 @@ var AppointmentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAppointment",
 			Handler:    _AppointmentService_GetAppointment_Handler,
 		},
-		{
-			MethodName: "UpdateAppointment",
-			Handler:    _AppointmentService_UpdateAppointment_Handler,
-		},
 		{
 			MethodName: "DeleteAppointment",
 			Handler:    _AppointmentService_DeleteAppointment_Handler,
 		},
+		{
+			MethodName: "CancelAppointment",
+			Handler:    _AppointmentService_CancelAppointment_Handler,
+		},
+		{
+			MethodName: "UpdateAppointmentStatus",
+			Handler:    _AppointmentService_UpdateAppointmentStatus_Handler,
+		},
+		{
+			MethodName: "BatchCreateAppointments",
+			Handler:    _AppointmentService_BatchCreateAppointments_Handler,
+		},
+		{
+			MethodName: "BatchDeleteAppointments",
+			Handler:    _AppointmentService_BatchDeleteAppointments_Handler,
+		},
+		{
+			MethodName: "RestoreAppointment",
+			Handler:    _AppointmentService_RestoreAppointment_Handler,
+		},
+		{
+			MethodName: "ListDeletedAppointments",
+			Handler:    _AppointmentService_ListDeletedAppointments_Handler,
+		},
+		{
+			MethodName: "PurgeAppointment",
+			Handler:    _AppointmentService_PurgeAppointment_Handler,
+		},
 		{
 			MethodName: "ListAppointments",
 			Handler:    _AppointmentService_ListAppointments_Handler,
 		},
+		{
+			MethodName: "ExportICS",
+			Handler:    _AppointmentService_ExportICS_Handler,
+		},
+		{
+			MethodName: "CreateFeedToken",
+			Handler:    _AppointmentService_CreateFeedToken_Handler,
+		},
+		{
+			MethodName: "RotateFeedToken",
+			Handler:    _AppointmentService_RotateFeedToken_Handler,
+		},
+		{
+			MethodName: "RevokeFeedToken",
+			Handler:    _AppointmentService_RevokeFeedToken_Handler,
+		},
+		{
+			MethodName: "ListFeedTokens",
+			Handler:    _AppointmentService_ListFeedTokens_Handler,
+		},
+		{
+			MethodName: "HoldSlot",
+			Handler:    _AppointmentService_HoldSlot_Handler,
+		},
+		{
+			MethodName: "ConfirmHold",
+			Handler:    _AppointmentService_ConfirmHold_Handler,
+		},
+		{
+			MethodName: "GetStatistics",
+			Handler:    _AppointmentService_GetStatistics_Handler,
+		},
+		{
+			MethodName: "GetCalendarSettings",
+			Handler:    _AppointmentService_GetCalendarSettings_Handler,
+		},
+		{
+			MethodName: "UpdateCalendarSettings",
+			Handler:    _AppointmentService_UpdateCalendarSettings_Handler,
+		},
+		{
+			MethodName: "GetAppointmentHistory",
+			Handler:    _AppointmentService_GetAppointmentHistory_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _AppointmentService_ListAuditEvents_Handler,
+		},
+		{
+			MethodName: "CreateAPIKey",
+			Handler:    _AppointmentService_CreateAPIKey_Handler,
+		},
+		{
+			MethodName: "ListAPIKeys",
+			Handler:    _AppointmentService_ListAPIKeys_Handler,
+		},
+		{
+			MethodName: "RevokeAPIKey",
+			Handler:    _AppointmentService_RevokeAPIKey_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportICS",
+			Handler:       _AppointmentService_ImportICS_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportAppointments",
+			Handler:       _AppointmentService_ExportAppointments_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportAppointments",
+			Handler:       _AppointmentService_ImportAppointments_Handler,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "StreamAppointments",
 			Handler:       _AppointmentService_StreamAppointments_Handler,
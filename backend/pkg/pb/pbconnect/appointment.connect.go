@@ -0,0 +1,1011 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: proto/appointment/appointment.proto
+
+package pbconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	pb "github.com/pasDamola/schedule-management-system/pkg/pb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AppointmentServiceName is the fully-qualified name of the AppointmentService service.
+	AppointmentServiceName = "appointment.AppointmentService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AppointmentServiceCreateAppointmentProcedure is the fully-qualified name of the
+	// AppointmentService's CreateAppointment RPC.
+	AppointmentServiceCreateAppointmentProcedure = "/appointment.AppointmentService/CreateAppointment"
+	// AppointmentServiceGetAppointmentProcedure is the fully-qualified name of the AppointmentService's
+	// GetAppointment RPC.
+	AppointmentServiceGetAppointmentProcedure = "/appointment.AppointmentService/GetAppointment"
+	// AppointmentServiceDeleteAppointmentProcedure is the fully-qualified name of the
+	// AppointmentService's DeleteAppointment RPC.
+	AppointmentServiceDeleteAppointmentProcedure = "/appointment.AppointmentService/DeleteAppointment"
+	// AppointmentServiceCancelAppointmentProcedure is the fully-qualified name of the
+	// AppointmentService's CancelAppointment RPC.
+	AppointmentServiceCancelAppointmentProcedure = "/appointment.AppointmentService/CancelAppointment"
+	// AppointmentServiceUpdateAppointmentStatusProcedure is the fully-qualified name of the
+	// AppointmentService's UpdateAppointmentStatus RPC.
+	AppointmentServiceUpdateAppointmentStatusProcedure = "/appointment.AppointmentService/UpdateAppointmentStatus"
+	// AppointmentServiceBatchCreateAppointmentsProcedure is the fully-qualified name of the
+	// AppointmentService's BatchCreateAppointments RPC.
+	AppointmentServiceBatchCreateAppointmentsProcedure = "/appointment.AppointmentService/BatchCreateAppointments"
+	// AppointmentServiceBatchDeleteAppointmentsProcedure is the fully-qualified name of the
+	// AppointmentService's BatchDeleteAppointments RPC.
+	AppointmentServiceBatchDeleteAppointmentsProcedure = "/appointment.AppointmentService/BatchDeleteAppointments"
+	// AppointmentServiceRestoreAppointmentProcedure is the fully-qualified name of the
+	// AppointmentService's RestoreAppointment RPC.
+	AppointmentServiceRestoreAppointmentProcedure = "/appointment.AppointmentService/RestoreAppointment"
+	// AppointmentServiceListDeletedAppointmentsProcedure is the fully-qualified name of the
+	// AppointmentService's ListDeletedAppointments RPC.
+	AppointmentServiceListDeletedAppointmentsProcedure = "/appointment.AppointmentService/ListDeletedAppointments"
+	// AppointmentServicePurgeAppointmentProcedure is the fully-qualified name of the
+	// AppointmentService's PurgeAppointment RPC.
+	AppointmentServicePurgeAppointmentProcedure = "/appointment.AppointmentService/PurgeAppointment"
+	// AppointmentServiceListAppointmentsProcedure is the fully-qualified name of the
+	// AppointmentService's ListAppointments RPC.
+	AppointmentServiceListAppointmentsProcedure = "/appointment.AppointmentService/ListAppointments"
+	// AppointmentServiceExportICSProcedure is the fully-qualified name of the AppointmentService's
+	// ExportICS RPC.
+	AppointmentServiceExportICSProcedure = "/appointment.AppointmentService/ExportICS"
+	// AppointmentServiceImportICSProcedure is the fully-qualified name of the AppointmentService's
+	// ImportICS RPC.
+	AppointmentServiceImportICSProcedure = "/appointment.AppointmentService/ImportICS"
+	// AppointmentServiceExportAppointmentsProcedure is the fully-qualified name of the
+	// AppointmentService's ExportAppointments RPC.
+	AppointmentServiceExportAppointmentsProcedure = "/appointment.AppointmentService/ExportAppointments"
+	// AppointmentServiceImportAppointmentsProcedure is the fully-qualified name of the
+	// AppointmentService's ImportAppointments RPC.
+	AppointmentServiceImportAppointmentsProcedure = "/appointment.AppointmentService/ImportAppointments"
+	// AppointmentServiceCreateFeedTokenProcedure is the fully-qualified name of the
+	// AppointmentService's CreateFeedToken RPC.
+	AppointmentServiceCreateFeedTokenProcedure = "/appointment.AppointmentService/CreateFeedToken"
+	// AppointmentServiceRotateFeedTokenProcedure is the fully-qualified name of the
+	// AppointmentService's RotateFeedToken RPC.
+	AppointmentServiceRotateFeedTokenProcedure = "/appointment.AppointmentService/RotateFeedToken"
+	// AppointmentServiceRevokeFeedTokenProcedure is the fully-qualified name of the
+	// AppointmentService's RevokeFeedToken RPC.
+	AppointmentServiceRevokeFeedTokenProcedure = "/appointment.AppointmentService/RevokeFeedToken"
+	// AppointmentServiceListFeedTokensProcedure is the fully-qualified name of the AppointmentService's
+	// ListFeedTokens RPC.
+	AppointmentServiceListFeedTokensProcedure = "/appointment.AppointmentService/ListFeedTokens"
+	// AppointmentServiceHoldSlotProcedure is the fully-qualified name of the AppointmentService's
+	// HoldSlot RPC.
+	AppointmentServiceHoldSlotProcedure = "/appointment.AppointmentService/HoldSlot"
+	// AppointmentServiceConfirmHoldProcedure is the fully-qualified name of the AppointmentService's
+	// ConfirmHold RPC.
+	AppointmentServiceConfirmHoldProcedure = "/appointment.AppointmentService/ConfirmHold"
+	// AppointmentServiceGetStatisticsProcedure is the fully-qualified name of the AppointmentService's
+	// GetStatistics RPC.
+	AppointmentServiceGetStatisticsProcedure = "/appointment.AppointmentService/GetStatistics"
+	// AppointmentServiceGetCalendarSettingsProcedure is the fully-qualified name of the
+	// AppointmentService's GetCalendarSettings RPC.
+	AppointmentServiceGetCalendarSettingsProcedure = "/appointment.AppointmentService/GetCalendarSettings"
+	// AppointmentServiceUpdateCalendarSettingsProcedure is the fully-qualified name of the
+	// AppointmentService's UpdateCalendarSettings RPC.
+	AppointmentServiceUpdateCalendarSettingsProcedure = "/appointment.AppointmentService/UpdateCalendarSettings"
+	// AppointmentServiceGetAppointmentHistoryProcedure is the fully-qualified name of the
+	// AppointmentService's GetAppointmentHistory RPC.
+	AppointmentServiceGetAppointmentHistoryProcedure = "/appointment.AppointmentService/GetAppointmentHistory"
+	// AppointmentServiceListAuditEventsProcedure is the fully-qualified name of the
+	// AppointmentService's ListAuditEvents RPC.
+	AppointmentServiceListAuditEventsProcedure = "/appointment.AppointmentService/ListAuditEvents"
+	// AppointmentServiceCreateAPIKeyProcedure is the fully-qualified name of the AppointmentService's
+	// CreateAPIKey RPC.
+	AppointmentServiceCreateAPIKeyProcedure = "/appointment.AppointmentService/CreateAPIKey"
+	// AppointmentServiceListAPIKeysProcedure is the fully-qualified name of the AppointmentService's
+	// ListAPIKeys RPC.
+	AppointmentServiceListAPIKeysProcedure = "/appointment.AppointmentService/ListAPIKeys"
+	// AppointmentServiceRevokeAPIKeyProcedure is the fully-qualified name of the AppointmentService's
+	// RevokeAPIKey RPC.
+	AppointmentServiceRevokeAPIKeyProcedure = "/appointment.AppointmentService/RevokeAPIKey"
+	// AppointmentServiceStreamAppointmentsProcedure is the fully-qualified name of the
+	// AppointmentService's StreamAppointments RPC.
+	AppointmentServiceStreamAppointmentsProcedure = "/appointment.AppointmentService/StreamAppointments"
+)
+
+// AppointmentServiceClient is a client for the appointment.AppointmentService service.
+type AppointmentServiceClient interface {
+	// Creates an appointment, rejecting it if the slot conflicts with another one
+	CreateAppointment(context.Context, *connect.Request[pb.CreateAppointmentRequest]) (*connect.Response[pb.Appointment], error)
+	// Returns a live appointment by ID
+	GetAppointment(context.Context, *connect.Request[pb.GetAppointmentRequest]) (*connect.Response[pb.Appointment], error)
+	// Moves an appointment to the trash
+	DeleteAppointment(context.Context, *connect.Request[pb.DeleteAppointmentRequest]) (*connect.Response[emptypb.Empty], error)
+	// Cancels an appointment, freeing its slot, with an optional reason
+	CancelAppointment(context.Context, *connect.Request[pb.CancelAppointmentRequest]) (*connect.Response[pb.Appointment], error)
+	// Moves an appointment to another status along the allowed transitions
+	UpdateAppointmentStatus(context.Context, *connect.Request[pb.UpdateAppointmentStatusRequest]) (*connect.Response[pb.Appointment], error)
+	// Creates up to 500 appointments, atomically or best effort
+	BatchCreateAppointments(context.Context, *connect.Request[pb.BatchCreateAppointmentsRequest]) (*connect.Response[pb.BatchAppointmentsResponse], error)
+	// Moves up to 500 appointments to the trash, atomically or best effort
+	BatchDeleteAppointments(context.Context, *connect.Request[pb.BatchDeleteAppointmentsRequest]) (*connect.Response[pb.BatchAppointmentsResponse], error)
+	// Brings an appointment back from the trash if its slot is still free
+	RestoreAppointment(context.Context, *connect.Request[pb.RestoreAppointmentRequest]) (*connect.Response[pb.Appointment], error)
+	// Lists the appointments in the trash
+	ListDeletedAppointments(context.Context, *connect.Request[pb.ListDeletedAppointmentsRequest]) (*connect.Response[pb.ListAppointmentsResponse], error)
+	// Permanently deletes an appointment from the trash
+	PurgeAppointment(context.Context, *connect.Request[pb.PurgeAppointmentRequest]) (*connect.Response[emptypb.Empty], error)
+	// Lists live appointments matching the filters, a page at a time
+	ListAppointments(context.Context, *connect.Request[pb.ListAppointmentsRequest]) (*connect.Response[pb.ListAppointmentsResponse], error)
+	// Exports the appointments matching the filters as an iCalendar file
+	ExportICS(context.Context, *connect.Request[pb.ExportICSRequest]) (*connect.Response[pb.ExportICSResponse], error)
+	// Imports the events of an iCalendar file
+	ImportICS(context.Context) *connect.ClientStreamForClient[pb.ImportICSRequest, pb.ImportICSReport]
+	// Streams the appointments matching the filters as CSV or NDJSON
+	ExportAppointments(context.Context, *connect.Request[pb.ExportAppointmentsRequest]) (*connect.ServerStreamForClient[pb.ExportAppointmentsChunk], error)
+	// Imports appointments from a CSV or NDJSON file with per-row results
+	ImportAppointments(context.Context) *connect.ClientStreamForClient[pb.ImportAppointmentsRequest, pb.ImportAppointmentsReport]
+	// Publishes a calendar at a new secret feed URL
+	CreateFeedToken(context.Context, *connect.Request[pb.CreateFeedTokenRequest]) (*connect.Response[pb.FeedToken], error)
+	// Replaces the secret of a feed URL
+	RotateFeedToken(context.Context, *connect.Request[pb.RotateFeedTokenRequest]) (*connect.Response[pb.FeedToken], error)
+	// Stops a feed URL working
+	RevokeFeedToken(context.Context, *connect.Request[pb.RevokeFeedTokenRequest]) (*connect.Response[emptypb.Empty], error)
+	// Lists the feed tokens of a calendar, without their secrets
+	ListFeedTokens(context.Context, *connect.Request[pb.ListFeedTokensRequest]) (*connect.Response[pb.ListFeedTokensResponse], error)
+	// Reserves a slot for a limited time while a booking is completed
+	HoldSlot(context.Context, *connect.Request[pb.HoldSlotRequest]) (*connect.Response[pb.Appointment], error)
+	// Turns a tentative hold into a regular appointment
+	ConfirmHold(context.Context, *connect.Request[pb.ConfirmHoldRequest]) (*connect.Response[pb.Appointment], error)
+	// Reports counts, booked hours and utilization for a period
+	GetStatistics(context.Context, *connect.Request[pb.GetStatisticsRequest]) (*connect.Response[pb.GetStatisticsResponse], error)
+	// Returns the settings of a calendar
+	GetCalendarSettings(context.Context, *connect.Request[pb.GetCalendarSettingsRequest]) (*connect.Response[pb.CalendarSettings], error)
+	// Changes the settings of a calendar
+	UpdateCalendarSettings(context.Context, *connect.Request[pb.UpdateCalendarSettingsRequest]) (*connect.Response[pb.CalendarSettings], error)
+	// Returns every recorded change of an appointment
+	GetAppointmentHistory(context.Context, *connect.Request[pb.GetAppointmentHistoryRequest]) (*connect.Response[pb.GetAppointmentHistoryResponse], error)
+	// Lists recorded changes across appointments
+	ListAuditEvents(context.Context, *connect.Request[pb.ListAuditEventsRequest]) (*connect.Response[pb.ListAuditEventsResponse], error)
+	// Issues an API key for a service integration; the key itself is only returned here
+	CreateAPIKey(context.Context, *connect.Request[pb.CreateAPIKeyRequest]) (*connect.Response[pb.APIKey], error)
+	// Lists the active API keys of the caller's tenant, without their secrets
+	ListAPIKeys(context.Context, *connect.Request[pb.ListAPIKeysRequest]) (*connect.Response[pb.ListAPIKeysResponse], error)
+	// Stops an API key working
+	RevokeAPIKey(context.Context, *connect.Request[pb.RevokeAPIKeyRequest]) (*connect.Response[emptypb.Empty], error)
+	// Streams appointment changes as they happen
+	StreamAppointments(context.Context, *connect.Request[emptypb.Empty]) (*connect.ServerStreamForClient[pb.AppointmentStreamResponse], error)
+}
+
+// NewAppointmentServiceClient constructs a client for the appointment.AppointmentService service.
+// By default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped
+// responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAppointmentServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AppointmentServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	appointmentServiceMethods := pb.File_proto_appointment_appointment_proto.Services().ByName("AppointmentService").Methods()
+	return &appointmentServiceClient{
+		createAppointment: connect.NewClient[pb.CreateAppointmentRequest, pb.Appointment](
+			httpClient,
+			baseURL+AppointmentServiceCreateAppointmentProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("CreateAppointment")),
+			connect.WithClientOptions(opts...),
+		),
+		getAppointment: connect.NewClient[pb.GetAppointmentRequest, pb.Appointment](
+			httpClient,
+			baseURL+AppointmentServiceGetAppointmentProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("GetAppointment")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteAppointment: connect.NewClient[pb.DeleteAppointmentRequest, emptypb.Empty](
+			httpClient,
+			baseURL+AppointmentServiceDeleteAppointmentProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("DeleteAppointment")),
+			connect.WithClientOptions(opts...),
+		),
+		cancelAppointment: connect.NewClient[pb.CancelAppointmentRequest, pb.Appointment](
+			httpClient,
+			baseURL+AppointmentServiceCancelAppointmentProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("CancelAppointment")),
+			connect.WithClientOptions(opts...),
+		),
+		updateAppointmentStatus: connect.NewClient[pb.UpdateAppointmentStatusRequest, pb.Appointment](
+			httpClient,
+			baseURL+AppointmentServiceUpdateAppointmentStatusProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("UpdateAppointmentStatus")),
+			connect.WithClientOptions(opts...),
+		),
+		batchCreateAppointments: connect.NewClient[pb.BatchCreateAppointmentsRequest, pb.BatchAppointmentsResponse](
+			httpClient,
+			baseURL+AppointmentServiceBatchCreateAppointmentsProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("BatchCreateAppointments")),
+			connect.WithClientOptions(opts...),
+		),
+		batchDeleteAppointments: connect.NewClient[pb.BatchDeleteAppointmentsRequest, pb.BatchAppointmentsResponse](
+			httpClient,
+			baseURL+AppointmentServiceBatchDeleteAppointmentsProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("BatchDeleteAppointments")),
+			connect.WithClientOptions(opts...),
+		),
+		restoreAppointment: connect.NewClient[pb.RestoreAppointmentRequest, pb.Appointment](
+			httpClient,
+			baseURL+AppointmentServiceRestoreAppointmentProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("RestoreAppointment")),
+			connect.WithClientOptions(opts...),
+		),
+		listDeletedAppointments: connect.NewClient[pb.ListDeletedAppointmentsRequest, pb.ListAppointmentsResponse](
+			httpClient,
+			baseURL+AppointmentServiceListDeletedAppointmentsProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("ListDeletedAppointments")),
+			connect.WithClientOptions(opts...),
+		),
+		purgeAppointment: connect.NewClient[pb.PurgeAppointmentRequest, emptypb.Empty](
+			httpClient,
+			baseURL+AppointmentServicePurgeAppointmentProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("PurgeAppointment")),
+			connect.WithClientOptions(opts...),
+		),
+		listAppointments: connect.NewClient[pb.ListAppointmentsRequest, pb.ListAppointmentsResponse](
+			httpClient,
+			baseURL+AppointmentServiceListAppointmentsProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("ListAppointments")),
+			connect.WithClientOptions(opts...),
+		),
+		exportICS: connect.NewClient[pb.ExportICSRequest, pb.ExportICSResponse](
+			httpClient,
+			baseURL+AppointmentServiceExportICSProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("ExportICS")),
+			connect.WithClientOptions(opts...),
+		),
+		importICS: connect.NewClient[pb.ImportICSRequest, pb.ImportICSReport](
+			httpClient,
+			baseURL+AppointmentServiceImportICSProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("ImportICS")),
+			connect.WithClientOptions(opts...),
+		),
+		exportAppointments: connect.NewClient[pb.ExportAppointmentsRequest, pb.ExportAppointmentsChunk](
+			httpClient,
+			baseURL+AppointmentServiceExportAppointmentsProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("ExportAppointments")),
+			connect.WithClientOptions(opts...),
+		),
+		importAppointments: connect.NewClient[pb.ImportAppointmentsRequest, pb.ImportAppointmentsReport](
+			httpClient,
+			baseURL+AppointmentServiceImportAppointmentsProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("ImportAppointments")),
+			connect.WithClientOptions(opts...),
+		),
+		createFeedToken: connect.NewClient[pb.CreateFeedTokenRequest, pb.FeedToken](
+			httpClient,
+			baseURL+AppointmentServiceCreateFeedTokenProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("CreateFeedToken")),
+			connect.WithClientOptions(opts...),
+		),
+		rotateFeedToken: connect.NewClient[pb.RotateFeedTokenRequest, pb.FeedToken](
+			httpClient,
+			baseURL+AppointmentServiceRotateFeedTokenProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("RotateFeedToken")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeFeedToken: connect.NewClient[pb.RevokeFeedTokenRequest, emptypb.Empty](
+			httpClient,
+			baseURL+AppointmentServiceRevokeFeedTokenProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("RevokeFeedToken")),
+			connect.WithClientOptions(opts...),
+		),
+		listFeedTokens: connect.NewClient[pb.ListFeedTokensRequest, pb.ListFeedTokensResponse](
+			httpClient,
+			baseURL+AppointmentServiceListFeedTokensProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("ListFeedTokens")),
+			connect.WithClientOptions(opts...),
+		),
+		holdSlot: connect.NewClient[pb.HoldSlotRequest, pb.Appointment](
+			httpClient,
+			baseURL+AppointmentServiceHoldSlotProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("HoldSlot")),
+			connect.WithClientOptions(opts...),
+		),
+		confirmHold: connect.NewClient[pb.ConfirmHoldRequest, pb.Appointment](
+			httpClient,
+			baseURL+AppointmentServiceConfirmHoldProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("ConfirmHold")),
+			connect.WithClientOptions(opts...),
+		),
+		getStatistics: connect.NewClient[pb.GetStatisticsRequest, pb.GetStatisticsResponse](
+			httpClient,
+			baseURL+AppointmentServiceGetStatisticsProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("GetStatistics")),
+			connect.WithClientOptions(opts...),
+		),
+		getCalendarSettings: connect.NewClient[pb.GetCalendarSettingsRequest, pb.CalendarSettings](
+			httpClient,
+			baseURL+AppointmentServiceGetCalendarSettingsProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("GetCalendarSettings")),
+			connect.WithClientOptions(opts...),
+		),
+		updateCalendarSettings: connect.NewClient[pb.UpdateCalendarSettingsRequest, pb.CalendarSettings](
+			httpClient,
+			baseURL+AppointmentServiceUpdateCalendarSettingsProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("UpdateCalendarSettings")),
+			connect.WithClientOptions(opts...),
+		),
+		getAppointmentHistory: connect.NewClient[pb.GetAppointmentHistoryRequest, pb.GetAppointmentHistoryResponse](
+			httpClient,
+			baseURL+AppointmentServiceGetAppointmentHistoryProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("GetAppointmentHistory")),
+			connect.WithClientOptions(opts...),
+		),
+		listAuditEvents: connect.NewClient[pb.ListAuditEventsRequest, pb.ListAuditEventsResponse](
+			httpClient,
+			baseURL+AppointmentServiceListAuditEventsProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("ListAuditEvents")),
+			connect.WithClientOptions(opts...),
+		),
+		createAPIKey: connect.NewClient[pb.CreateAPIKeyRequest, pb.APIKey](
+			httpClient,
+			baseURL+AppointmentServiceCreateAPIKeyProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("CreateAPIKey")),
+			connect.WithClientOptions(opts...),
+		),
+		listAPIKeys: connect.NewClient[pb.ListAPIKeysRequest, pb.ListAPIKeysResponse](
+			httpClient,
+			baseURL+AppointmentServiceListAPIKeysProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("ListAPIKeys")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeAPIKey: connect.NewClient[pb.RevokeAPIKeyRequest, emptypb.Empty](
+			httpClient,
+			baseURL+AppointmentServiceRevokeAPIKeyProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("RevokeAPIKey")),
+			connect.WithClientOptions(opts...),
+		),
+		streamAppointments: connect.NewClient[emptypb.Empty, pb.AppointmentStreamResponse](
+			httpClient,
+			baseURL+AppointmentServiceStreamAppointmentsProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("StreamAppointments")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// appointmentServiceClient implements AppointmentServiceClient.
+type appointmentServiceClient struct {
+	createAppointment       *connect.Client[pb.CreateAppointmentRequest, pb.Appointment]
+	getAppointment          *connect.Client[pb.GetAppointmentRequest, pb.Appointment]
+	deleteAppointment       *connect.Client[pb.DeleteAppointmentRequest, emptypb.Empty]
+	cancelAppointment       *connect.Client[pb.CancelAppointmentRequest, pb.Appointment]
+	updateAppointmentStatus *connect.Client[pb.UpdateAppointmentStatusRequest, pb.Appointment]
+	batchCreateAppointments *connect.Client[pb.BatchCreateAppointmentsRequest, pb.BatchAppointmentsResponse]
+	batchDeleteAppointments *connect.Client[pb.BatchDeleteAppointmentsRequest, pb.BatchAppointmentsResponse]
+	restoreAppointment      *connect.Client[pb.RestoreAppointmentRequest, pb.Appointment]
+	listDeletedAppointments *connect.Client[pb.ListDeletedAppointmentsRequest, pb.ListAppointmentsResponse]
+	purgeAppointment        *connect.Client[pb.PurgeAppointmentRequest, emptypb.Empty]
+	listAppointments        *connect.Client[pb.ListAppointmentsRequest, pb.ListAppointmentsResponse]
+	exportICS               *connect.Client[pb.ExportICSRequest, pb.ExportICSResponse]
+	importICS               *connect.Client[pb.ImportICSRequest, pb.ImportICSReport]
+	exportAppointments      *connect.Client[pb.ExportAppointmentsRequest, pb.ExportAppointmentsChunk]
+	importAppointments      *connect.Client[pb.ImportAppointmentsRequest, pb.ImportAppointmentsReport]
+	createFeedToken         *connect.Client[pb.CreateFeedTokenRequest, pb.FeedToken]
+	rotateFeedToken         *connect.Client[pb.RotateFeedTokenRequest, pb.FeedToken]
+	revokeFeedToken         *connect.Client[pb.RevokeFeedTokenRequest, emptypb.Empty]
+	listFeedTokens          *connect.Client[pb.ListFeedTokensRequest, pb.ListFeedTokensResponse]
+	holdSlot                *connect.Client[pb.HoldSlotRequest, pb.Appointment]
+	confirmHold             *connect.Client[pb.ConfirmHoldRequest, pb.Appointment]
+	getStatistics           *connect.Client[pb.GetStatisticsRequest, pb.GetStatisticsResponse]
+	getCalendarSettings     *connect.Client[pb.GetCalendarSettingsRequest, pb.CalendarSettings]
+	updateCalendarSettings  *connect.Client[pb.UpdateCalendarSettingsRequest, pb.CalendarSettings]
+	getAppointmentHistory   *connect.Client[pb.GetAppointmentHistoryRequest, pb.GetAppointmentHistoryResponse]
+	listAuditEvents         *connect.Client[pb.ListAuditEventsRequest, pb.ListAuditEventsResponse]
+	createAPIKey            *connect.Client[pb.CreateAPIKeyRequest, pb.APIKey]
+	listAPIKeys             *connect.Client[pb.ListAPIKeysRequest, pb.ListAPIKeysResponse]
+	revokeAPIKey            *connect.Client[pb.RevokeAPIKeyRequest, emptypb.Empty]
+	streamAppointments      *connect.Client[emptypb.Empty, pb.AppointmentStreamResponse]
+}
+
+// CreateAppointment calls appointment.AppointmentService.CreateAppointment.
+func (c *appointmentServiceClient) CreateAppointment(ctx context.Context, req *connect.Request[pb.CreateAppointmentRequest]) (*connect.Response[pb.Appointment], error) {
+	return c.createAppointment.CallUnary(ctx, req)
+}
+
+// GetAppointment calls appointment.AppointmentService.GetAppointment.
+func (c *appointmentServiceClient) GetAppointment(ctx context.Context, req *connect.Request[pb.GetAppointmentRequest]) (*connect.Response[pb.Appointment], error) {
+	return c.getAppointment.CallUnary(ctx, req)
+}
+
+// DeleteAppointment calls appointment.AppointmentService.DeleteAppointment.
+func (c *appointmentServiceClient) DeleteAppointment(ctx context.Context, req *connect.Request[pb.DeleteAppointmentRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteAppointment.CallUnary(ctx, req)
+}
+
+// CancelAppointment calls appointment.AppointmentService.CancelAppointment.
+func (c *appointmentServiceClient) CancelAppointment(ctx context.Context, req *connect.Request[pb.CancelAppointmentRequest]) (*connect.Response[pb.Appointment], error) {
+	return c.cancelAppointment.CallUnary(ctx, req)
+}
+
+// UpdateAppointmentStatus calls appointment.AppointmentService.UpdateAppointmentStatus.
+func (c *appointmentServiceClient) UpdateAppointmentStatus(ctx context.Context, req *connect.Request[pb.UpdateAppointmentStatusRequest]) (*connect.Response[pb.Appointment], error) {
+	return c.updateAppointmentStatus.CallUnary(ctx, req)
+}
+
+// BatchCreateAppointments calls appointment.AppointmentService.BatchCreateAppointments.
+func (c *appointmentServiceClient) BatchCreateAppointments(ctx context.Context, req *connect.Request[pb.BatchCreateAppointmentsRequest]) (*connect.Response[pb.BatchAppointmentsResponse], error) {
+	return c.batchCreateAppointments.CallUnary(ctx, req)
+}
+
+// BatchDeleteAppointments calls appointment.AppointmentService.BatchDeleteAppointments.
+func (c *appointmentServiceClient) BatchDeleteAppointments(ctx context.Context, req *connect.Request[pb.BatchDeleteAppointmentsRequest]) (*connect.Response[pb.BatchAppointmentsResponse], error) {
+	return c.batchDeleteAppointments.CallUnary(ctx, req)
+}
+
+// RestoreAppointment calls appointment.AppointmentService.RestoreAppointment.
+func (c *appointmentServiceClient) RestoreAppointment(ctx context.Context, req *connect.Request[pb.RestoreAppointmentRequest]) (*connect.Response[pb.Appointment], error) {
+	return c.restoreAppointment.CallUnary(ctx, req)
+}
+
+// ListDeletedAppointments calls appointment.AppointmentService.ListDeletedAppointments.
+func (c *appointmentServiceClient) ListDeletedAppointments(ctx context.Context, req *connect.Request[pb.ListDeletedAppointmentsRequest]) (*connect.Response[pb.ListAppointmentsResponse], error) {
+	return c.listDeletedAppointments.CallUnary(ctx, req)
+}
+
+// PurgeAppointment calls appointment.AppointmentService.PurgeAppointment.
+func (c *appointmentServiceClient) PurgeAppointment(ctx context.Context, req *connect.Request[pb.PurgeAppointmentRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.purgeAppointment.CallUnary(ctx, req)
+}
+
+// ListAppointments calls appointment.AppointmentService.ListAppointments.
+func (c *appointmentServiceClient) ListAppointments(ctx context.Context, req *connect.Request[pb.ListAppointmentsRequest]) (*connect.Response[pb.ListAppointmentsResponse], error) {
+	return c.listAppointments.CallUnary(ctx, req)
+}
+
+// ExportICS calls appointment.AppointmentService.ExportICS.
+func (c *appointmentServiceClient) ExportICS(ctx context.Context, req *connect.Request[pb.ExportICSRequest]) (*connect.Response[pb.ExportICSResponse], error) {
+	return c.exportICS.CallUnary(ctx, req)
+}
+
+// ImportICS calls appointment.AppointmentService.ImportICS.
+func (c *appointmentServiceClient) ImportICS(ctx context.Context) *connect.ClientStreamForClient[pb.ImportICSRequest, pb.ImportICSReport] {
+	return c.importICS.CallClientStream(ctx)
+}
+
+// ExportAppointments calls appointment.AppointmentService.ExportAppointments.
+func (c *appointmentServiceClient) ExportAppointments(ctx context.Context, req *connect.Request[pb.ExportAppointmentsRequest]) (*connect.ServerStreamForClient[pb.ExportAppointmentsChunk], error) {
+	return c.exportAppointments.CallServerStream(ctx, req)
+}
+
+// ImportAppointments calls appointment.AppointmentService.ImportAppointments.
+func (c *appointmentServiceClient) ImportAppointments(ctx context.Context) *connect.ClientStreamForClient[pb.ImportAppointmentsRequest, pb.ImportAppointmentsReport] {
+	return c.importAppointments.CallClientStream(ctx)
+}
+
+// CreateFeedToken calls appointment.AppointmentService.CreateFeedToken.
+func (c *appointmentServiceClient) CreateFeedToken(ctx context.Context, req *connect.Request[pb.CreateFeedTokenRequest]) (*connect.Response[pb.FeedToken], error) {
+	return c.createFeedToken.CallUnary(ctx, req)
+}
+
+// RotateFeedToken calls appointment.AppointmentService.RotateFeedToken.
+func (c *appointmentServiceClient) RotateFeedToken(ctx context.Context, req *connect.Request[pb.RotateFeedTokenRequest]) (*connect.Response[pb.FeedToken], error) {
+	return c.rotateFeedToken.CallUnary(ctx, req)
+}
+
+// RevokeFeedToken calls appointment.AppointmentService.RevokeFeedToken.
+func (c *appointmentServiceClient) RevokeFeedToken(ctx context.Context, req *connect.Request[pb.RevokeFeedTokenRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.revokeFeedToken.CallUnary(ctx, req)
+}
+
+// ListFeedTokens calls appointment.AppointmentService.ListFeedTokens.
+func (c *appointmentServiceClient) ListFeedTokens(ctx context.Context, req *connect.Request[pb.ListFeedTokensRequest]) (*connect.Response[pb.ListFeedTokensResponse], error) {
+	return c.listFeedTokens.CallUnary(ctx, req)
+}
+
+// HoldSlot calls appointment.AppointmentService.HoldSlot.
+func (c *appointmentServiceClient) HoldSlot(ctx context.Context, req *connect.Request[pb.HoldSlotRequest]) (*connect.Response[pb.Appointment], error) {
+	return c.holdSlot.CallUnary(ctx, req)
+}
+
+// ConfirmHold calls appointment.AppointmentService.ConfirmHold.
+func (c *appointmentServiceClient) ConfirmHold(ctx context.Context, req *connect.Request[pb.ConfirmHoldRequest]) (*connect.Response[pb.Appointment], error) {
+	return c.confirmHold.CallUnary(ctx, req)
+}
+
+// GetStatistics calls appointment.AppointmentService.GetStatistics.
+func (c *appointmentServiceClient) GetStatistics(ctx context.Context, req *connect.Request[pb.GetStatisticsRequest]) (*connect.Response[pb.GetStatisticsResponse], error) {
+	return c.getStatistics.CallUnary(ctx, req)
+}
+
+// GetCalendarSettings calls appointment.AppointmentService.GetCalendarSettings.
+func (c *appointmentServiceClient) GetCalendarSettings(ctx context.Context, req *connect.Request[pb.GetCalendarSettingsRequest]) (*connect.Response[pb.CalendarSettings], error) {
+	return c.getCalendarSettings.CallUnary(ctx, req)
+}
+
+// UpdateCalendarSettings calls appointment.AppointmentService.UpdateCalendarSettings.
+func (c *appointmentServiceClient) UpdateCalendarSettings(ctx context.Context, req *connect.Request[pb.UpdateCalendarSettingsRequest]) (*connect.Response[pb.CalendarSettings], error) {
+	return c.updateCalendarSettings.CallUnary(ctx, req)
+}
+
+// GetAppointmentHistory calls appointment.AppointmentService.GetAppointmentHistory.
+func (c *appointmentServiceClient) GetAppointmentHistory(ctx context.Context, req *connect.Request[pb.GetAppointmentHistoryRequest]) (*connect.Response[pb.GetAppointmentHistoryResponse], error) {
+	return c.getAppointmentHistory.CallUnary(ctx, req)
+}
+
+// ListAuditEvents calls appointment.AppointmentService.ListAuditEvents.
+func (c *appointmentServiceClient) ListAuditEvents(ctx context.Context, req *connect.Request[pb.ListAuditEventsRequest]) (*connect.Response[pb.ListAuditEventsResponse], error) {
+	return c.listAuditEvents.CallUnary(ctx, req)
+}
+
+// CreateAPIKey calls appointment.AppointmentService.CreateAPIKey.
+func (c *appointmentServiceClient) CreateAPIKey(ctx context.Context, req *connect.Request[pb.CreateAPIKeyRequest]) (*connect.Response[pb.APIKey], error) {
+	return c.createAPIKey.CallUnary(ctx, req)
+}
+
+// ListAPIKeys calls appointment.AppointmentService.ListAPIKeys.
+func (c *appointmentServiceClient) ListAPIKeys(ctx context.Context, req *connect.Request[pb.ListAPIKeysRequest]) (*connect.Response[pb.ListAPIKeysResponse], error) {
+	return c.listAPIKeys.CallUnary(ctx, req)
+}
+
+// RevokeAPIKey calls appointment.AppointmentService.RevokeAPIKey.
+func (c *appointmentServiceClient) RevokeAPIKey(ctx context.Context, req *connect.Request[pb.RevokeAPIKeyRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.revokeAPIKey.CallUnary(ctx, req)
+}
+
+// StreamAppointments calls appointment.AppointmentService.StreamAppointments.
+func (c *appointmentServiceClient) StreamAppointments(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.ServerStreamForClient[pb.AppointmentStreamResponse], error) {
+	return c.streamAppointments.CallServerStream(ctx, req)
+}
+
+// AppointmentServiceHandler is an implementation of the appointment.AppointmentService service.
+type AppointmentServiceHandler interface {
+	// Creates an appointment, rejecting it if the slot conflicts with another one
+	CreateAppointment(context.Context, *connect.Request[pb.CreateAppointmentRequest]) (*connect.Response[pb.Appointment], error)
+	// Returns a live appointment by ID
+	GetAppointment(context.Context, *connect.Request[pb.GetAppointmentRequest]) (*connect.Response[pb.Appointment], error)
+	// Moves an appointment to the trash
+	DeleteAppointment(context.Context, *connect.Request[pb.DeleteAppointmentRequest]) (*connect.Response[emptypb.Empty], error)
+	// Cancels an appointment, freeing its slot, with an optional reason
+	CancelAppointment(context.Context, *connect.Request[pb.CancelAppointmentRequest]) (*connect.Response[pb.Appointment], error)
+	// Moves an appointment to another status along the allowed transitions
+	UpdateAppointmentStatus(context.Context, *connect.Request[pb.UpdateAppointmentStatusRequest]) (*connect.Response[pb.Appointment], error)
+	// Creates up to 500 appointments, atomically or best effort
+	BatchCreateAppointments(context.Context, *connect.Request[pb.BatchCreateAppointmentsRequest]) (*connect.Response[pb.BatchAppointmentsResponse], error)
+	// Moves up to 500 appointments to the trash, atomically or best effort
+	BatchDeleteAppointments(context.Context, *connect.Request[pb.BatchDeleteAppointmentsRequest]) (*connect.Response[pb.BatchAppointmentsResponse], error)
+	// Brings an appointment back from the trash if its slot is still free
+	RestoreAppointment(context.Context, *connect.Request[pb.RestoreAppointmentRequest]) (*connect.Response[pb.Appointment], error)
+	// Lists the appointments in the trash
+	ListDeletedAppointments(context.Context, *connect.Request[pb.ListDeletedAppointmentsRequest]) (*connect.Response[pb.ListAppointmentsResponse], error)
+	// Permanently deletes an appointment from the trash
+	PurgeAppointment(context.Context, *connect.Request[pb.PurgeAppointmentRequest]) (*connect.Response[emptypb.Empty], error)
+	// Lists live appointments matching the filters, a page at a time
+	ListAppointments(context.Context, *connect.Request[pb.ListAppointmentsRequest]) (*connect.Response[pb.ListAppointmentsResponse], error)
+	// Exports the appointments matching the filters as an iCalendar file
+	ExportICS(context.Context, *connect.Request[pb.ExportICSRequest]) (*connect.Response[pb.ExportICSResponse], error)
+	// Imports the events of an iCalendar file
+	ImportICS(context.Context, *connect.ClientStream[pb.ImportICSRequest]) (*connect.Response[pb.ImportICSReport], error)
+	// Streams the appointments matching the filters as CSV or NDJSON
+	ExportAppointments(context.Context, *connect.Request[pb.ExportAppointmentsRequest], *connect.ServerStream[pb.ExportAppointmentsChunk]) error
+	// Imports appointments from a CSV or NDJSON file with per-row results
+	ImportAppointments(context.Context, *connect.ClientStream[pb.ImportAppointmentsRequest]) (*connect.Response[pb.ImportAppointmentsReport], error)
+	// Publishes a calendar at a new secret feed URL
+	CreateFeedToken(context.Context, *connect.Request[pb.CreateFeedTokenRequest]) (*connect.Response[pb.FeedToken], error)
+	// Replaces the secret of a feed URL
+	RotateFeedToken(context.Context, *connect.Request[pb.RotateFeedTokenRequest]) (*connect.Response[pb.FeedToken], error)
+	// Stops a feed URL working
+	RevokeFeedToken(context.Context, *connect.Request[pb.RevokeFeedTokenRequest]) (*connect.Response[emptypb.Empty], error)
+	// Lists the feed tokens of a calendar, without their secrets
+	ListFeedTokens(context.Context, *connect.Request[pb.ListFeedTokensRequest]) (*connect.Response[pb.ListFeedTokensResponse], error)
+	// Reserves a slot for a limited time while a booking is completed
+	HoldSlot(context.Context, *connect.Request[pb.HoldSlotRequest]) (*connect.Response[pb.Appointment], error)
+	// Turns a tentative hold into a regular appointment
+	ConfirmHold(context.Context, *connect.Request[pb.ConfirmHoldRequest]) (*connect.Response[pb.Appointment], error)
+	// Reports counts, booked hours and utilization for a period
+	GetStatistics(context.Context, *connect.Request[pb.GetStatisticsRequest]) (*connect.Response[pb.GetStatisticsResponse], error)
+	// Returns the settings of a calendar
+	GetCalendarSettings(context.Context, *connect.Request[pb.GetCalendarSettingsRequest]) (*connect.Response[pb.CalendarSettings], error)
+	// Changes the settings of a calendar
+	UpdateCalendarSettings(context.Context, *connect.Request[pb.UpdateCalendarSettingsRequest]) (*connect.Response[pb.CalendarSettings], error)
+	// Returns every recorded change of an appointment
+	GetAppointmentHistory(context.Context, *connect.Request[pb.GetAppointmentHistoryRequest]) (*connect.Response[pb.GetAppointmentHistoryResponse], error)
+	// Lists recorded changes across appointments
+	ListAuditEvents(context.Context, *connect.Request[pb.ListAuditEventsRequest]) (*connect.Response[pb.ListAuditEventsResponse], error)
+	// Issues an API key for a service integration; the key itself is only returned here
+	CreateAPIKey(context.Context, *connect.Request[pb.CreateAPIKeyRequest]) (*connect.Response[pb.APIKey], error)
+	// Lists the active API keys of the caller's tenant, without their secrets
+	ListAPIKeys(context.Context, *connect.Request[pb.ListAPIKeysRequest]) (*connect.Response[pb.ListAPIKeysResponse], error)
+	// Stops an API key working
+	RevokeAPIKey(context.Context, *connect.Request[pb.RevokeAPIKeyRequest]) (*connect.Response[emptypb.Empty], error)
+	// Streams appointment changes as they happen
+	StreamAppointments(context.Context, *connect.Request[emptypb.Empty], *connect.ServerStream[pb.AppointmentStreamResponse]) error
+}
+
+// NewAppointmentServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAppointmentServiceHandler(svc AppointmentServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	appointmentServiceMethods := pb.File_proto_appointment_appointment_proto.Services().ByName("AppointmentService").Methods()
+	appointmentServiceCreateAppointmentHandler := connect.NewUnaryHandler(
+		AppointmentServiceCreateAppointmentProcedure,
+		svc.CreateAppointment,
+		connect.WithSchema(appointmentServiceMethods.ByName("CreateAppointment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appointmentServiceGetAppointmentHandler := connect.NewUnaryHandler(
+		AppointmentServiceGetAppointmentProcedure,
+		svc.GetAppointment,
+		connect.WithSchema(appointmentServiceMethods.ByName("GetAppointment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appointmentServiceDeleteAppointmentHandler := connect.NewUnaryHandler(
+		AppointmentServiceDeleteAppointmentProcedure,
+		svc.DeleteAppointment,
+		connect.WithSchema(appointmentServiceMethods.ByName("DeleteAppointment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appointmentServiceCancelAppointmentHandler := connect.NewUnaryHandler(
+		AppointmentServiceCancelAppointmentProcedure,
+		svc.CancelAppointment,
+		connect.WithSchema(appointmentServiceMethods.ByName("CancelAppointment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appointmentServiceUpdateAppointmentStatusHandler := connect.NewUnaryHandler(
+		AppointmentServiceUpdateAppointmentStatusProcedure,
+		svc.UpdateAppointmentStatus,
+		connect.WithSchema(appointmentServiceMethods.ByName("UpdateAppointmentStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appointmentServiceBatchCreateAppointmentsHandler := connect.NewUnaryHandler(
+		AppointmentServiceBatchCreateAppointmentsProcedure,
+		svc.BatchCreateAppointments,
+		connect.WithSchema(appointmentServiceMethods.ByName("BatchCreateAppointments")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appointmentServiceBatchDeleteAppointmentsHandler := connect.NewUnaryHandler(
+		AppointmentServiceBatchDeleteAppointmentsProcedure,
+		svc.BatchDeleteAppointments,
+		connect.WithSchema(appointmentServiceMethods.ByName("BatchDeleteAppointments")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appointmentServiceRestoreAppointmentHandler := connect.NewUnaryHandler(
+		AppointmentServiceRestoreAppointmentProcedure,
+		svc.RestoreAppointment,
+		connect.WithSchema(appointmentServiceMethods.ByName("RestoreAppointment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appointmentServiceListDeletedAppointmentsHandler := connect.NewUnaryHandler(
+		AppointmentServiceListDeletedAppointmentsProcedure,
+		svc.ListDeletedAppointments,
+		connect.WithSchema(appointmentServiceMethods.ByName("ListDeletedAppointments")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appointmentServicePurgeAppointmentHandler := connect.NewUnaryHandler(
+		AppointmentServicePurgeAppointmentProcedure,
+		svc.PurgeAppointment,
+		connect.WithSchema(appointmentServiceMethods.ByName("PurgeAppointment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appointmentServiceListAppointmentsHandler := connect.NewUnaryHandler(
+		AppointmentServiceListAppointmentsProcedure,
+		svc.ListAppointments,
+		connect.WithSchema(appointmentServiceMethods.ByName("ListAppointments")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appointmentServiceExportICSHandler := connect.NewUnaryHandler(
+		AppointmentServiceExportICSProcedure,
+		svc.ExportICS,
+		connect.WithSchema(appointmentServiceMethods.ByName("ExportICS")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appointmentServiceImportICSHandler := connect.NewClientStreamHandler(
+		AppointmentServiceImportICSProcedure,
+		svc.ImportICS,
+		connect.WithSchema(appointmentServiceMethods.ByName("ImportICS")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appointmentServiceExportAppointmentsHandler := connect.NewServerStreamHandler(
+		AppointmentServiceExportAppointmentsProcedure,
+		svc.ExportAppointments,
+		connect.WithSchema(appointmentServiceMethods.ByName("ExportAppointments")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appointmentServiceImportAppointmentsHandler := connect.NewClientStreamHandler(
+		AppointmentServiceImportAppointmentsProcedure,
+		svc.ImportAppointments,
+		connect.WithSchema(appointmentServiceMethods.ByName("ImportAppointments")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appointmentServiceCreateFeedTokenHandler := connect.NewUnaryHandler(
+		AppointmentServiceCreateFeedTokenProcedure,
+		svc.CreateFeedToken,
+		connect.WithSchema(appointmentServiceMethods.ByName("CreateFeedToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appointmentServiceRotateFeedTokenHandler := connect.NewUnaryHandler(
+		AppointmentServiceRotateFeedTokenProcedure,
+		svc.RotateFeedToken,
+		connect.WithSchema(appointmentServiceMethods.ByName("RotateFeedToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appointmentServiceRevokeFeedTokenHandler := connect.NewUnaryHandler(
+		AppointmentServiceRevokeFeedTokenProcedure,
+		svc.RevokeFeedToken,
+		connect.WithSchema(appointmentServiceMethods.ByName("RevokeFeedToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appointmentServiceListFeedTokensHandler := connect.NewUnaryHandler(
+		AppointmentServiceListFeedTokensProcedure,
+		svc.ListFeedTokens,
+		connect.WithSchema(appointmentServiceMethods.ByName("ListFeedTokens")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appointmentServiceHoldSlotHandler := connect.NewUnaryHandler(
+		AppointmentServiceHoldSlotProcedure,
+		svc.HoldSlot,
+		connect.WithSchema(appointmentServiceMethods.ByName("HoldSlot")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appointmentServiceConfirmHoldHandler := connect.NewUnaryHandler(
+		AppointmentServiceConfirmHoldProcedure,
+		svc.ConfirmHold,
+		connect.WithSchema(appointmentServiceMethods.ByName("ConfirmHold")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appointmentServiceGetStatisticsHandler := connect.NewUnaryHandler(
+		AppointmentServiceGetStatisticsProcedure,
+		svc.GetStatistics,
+		connect.WithSchema(appointmentServiceMethods.ByName("GetStatistics")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appointmentServiceGetCalendarSettingsHandler := connect.NewUnaryHandler(
+		AppointmentServiceGetCalendarSettingsProcedure,
+		svc.GetCalendarSettings,
+		connect.WithSchema(appointmentServiceMethods.ByName("GetCalendarSettings")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appointmentServiceUpdateCalendarSettingsHandler := connect.NewUnaryHandler(
+		AppointmentServiceUpdateCalendarSettingsProcedure,
+		svc.UpdateCalendarSettings,
+		connect.WithSchema(appointmentServiceMethods.ByName("UpdateCalendarSettings")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appointmentServiceGetAppointmentHistoryHandler := connect.NewUnaryHandler(
+		AppointmentServiceGetAppointmentHistoryProcedure,
+		svc.GetAppointmentHistory,
+		connect.WithSchema(appointmentServiceMethods.ByName("GetAppointmentHistory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appointmentServiceListAuditEventsHandler := connect.NewUnaryHandler(
+		AppointmentServiceListAuditEventsProcedure,
+		svc.ListAuditEvents,
+		connect.WithSchema(appointmentServiceMethods.ByName("ListAuditEvents")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appointmentServiceCreateAPIKeyHandler := connect.NewUnaryHandler(
+		AppointmentServiceCreateAPIKeyProcedure,
+		svc.CreateAPIKey,
+		connect.WithSchema(appointmentServiceMethods.ByName("CreateAPIKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appointmentServiceListAPIKeysHandler := connect.NewUnaryHandler(
+		AppointmentServiceListAPIKeysProcedure,
+		svc.ListAPIKeys,
+		connect.WithSchema(appointmentServiceMethods.ByName("ListAPIKeys")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appointmentServiceRevokeAPIKeyHandler := connect.NewUnaryHandler(
+		AppointmentServiceRevokeAPIKeyProcedure,
+		svc.RevokeAPIKey,
+		connect.WithSchema(appointmentServiceMethods.ByName("RevokeAPIKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appointmentServiceStreamAppointmentsHandler := connect.NewServerStreamHandler(
+		AppointmentServiceStreamAppointmentsProcedure,
+		svc.StreamAppointments,
+		connect.WithSchema(appointmentServiceMethods.ByName("StreamAppointments")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/appointment.AppointmentService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AppointmentServiceCreateAppointmentProcedure:
+			appointmentServiceCreateAppointmentHandler.ServeHTTP(w, r)
+		case AppointmentServiceGetAppointmentProcedure:
+			appointmentServiceGetAppointmentHandler.ServeHTTP(w, r)
+		case AppointmentServiceDeleteAppointmentProcedure:
+			appointmentServiceDeleteAppointmentHandler.ServeHTTP(w, r)
+		case AppointmentServiceCancelAppointmentProcedure:
+			appointmentServiceCancelAppointmentHandler.ServeHTTP(w, r)
+		case AppointmentServiceUpdateAppointmentStatusProcedure:
+			appointmentServiceUpdateAppointmentStatusHandler.ServeHTTP(w, r)
+		case AppointmentServiceBatchCreateAppointmentsProcedure:
+			appointmentServiceBatchCreateAppointmentsHandler.ServeHTTP(w, r)
+		case AppointmentServiceBatchDeleteAppointmentsProcedure:
+			appointmentServiceBatchDeleteAppointmentsHandler.ServeHTTP(w, r)
+		case AppointmentServiceRestoreAppointmentProcedure:
+			appointmentServiceRestoreAppointmentHandler.ServeHTTP(w, r)
+		case AppointmentServiceListDeletedAppointmentsProcedure:
+			appointmentServiceListDeletedAppointmentsHandler.ServeHTTP(w, r)
+		case AppointmentServicePurgeAppointmentProcedure:
+			appointmentServicePurgeAppointmentHandler.ServeHTTP(w, r)
+		case AppointmentServiceListAppointmentsProcedure:
+			appointmentServiceListAppointmentsHandler.ServeHTTP(w, r)
+		case AppointmentServiceExportICSProcedure:
+			appointmentServiceExportICSHandler.ServeHTTP(w, r)
+		case AppointmentServiceImportICSProcedure:
+			appointmentServiceImportICSHandler.ServeHTTP(w, r)
+		case AppointmentServiceExportAppointmentsProcedure:
+			appointmentServiceExportAppointmentsHandler.ServeHTTP(w, r)
+		case AppointmentServiceImportAppointmentsProcedure:
+			appointmentServiceImportAppointmentsHandler.ServeHTTP(w, r)
+		case AppointmentServiceCreateFeedTokenProcedure:
+			appointmentServiceCreateFeedTokenHandler.ServeHTTP(w, r)
+		case AppointmentServiceRotateFeedTokenProcedure:
+			appointmentServiceRotateFeedTokenHandler.ServeHTTP(w, r)
+		case AppointmentServiceRevokeFeedTokenProcedure:
+			appointmentServiceRevokeFeedTokenHandler.ServeHTTP(w, r)
+		case AppointmentServiceListFeedTokensProcedure:
+			appointmentServiceListFeedTokensHandler.ServeHTTP(w, r)
+		case AppointmentServiceHoldSlotProcedure:
+			appointmentServiceHoldSlotHandler.ServeHTTP(w, r)
+		case AppointmentServiceConfirmHoldProcedure:
+			appointmentServiceConfirmHoldHandler.ServeHTTP(w, r)
+		case AppointmentServiceGetStatisticsProcedure:
+			appointmentServiceGetStatisticsHandler.ServeHTTP(w, r)
+		case AppointmentServiceGetCalendarSettingsProcedure:
+			appointmentServiceGetCalendarSettingsHandler.ServeHTTP(w, r)
+		case AppointmentServiceUpdateCalendarSettingsProcedure:
+			appointmentServiceUpdateCalendarSettingsHandler.ServeHTTP(w, r)
+		case AppointmentServiceGetAppointmentHistoryProcedure:
+			appointmentServiceGetAppointmentHistoryHandler.ServeHTTP(w, r)
+		case AppointmentServiceListAuditEventsProcedure:
+			appointmentServiceListAuditEventsHandler.ServeHTTP(w, r)
+		case AppointmentServiceCreateAPIKeyProcedure:
+			appointmentServiceCreateAPIKeyHandler.ServeHTTP(w, r)
+		case AppointmentServiceListAPIKeysProcedure:
+			appointmentServiceListAPIKeysHandler.ServeHTTP(w, r)
+		case AppointmentServiceRevokeAPIKeyProcedure:
+			appointmentServiceRevokeAPIKeyHandler.ServeHTTP(w, r)
+		case AppointmentServiceStreamAppointmentsProcedure:
+			appointmentServiceStreamAppointmentsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAppointmentServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAppointmentServiceHandler struct{}
+
+func (UnimplementedAppointmentServiceHandler) CreateAppointment(context.Context, *connect.Request[pb.CreateAppointmentRequest]) (*connect.Response[pb.Appointment], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.CreateAppointment is not implemented"))
+}
+
+func (UnimplementedAppointmentServiceHandler) GetAppointment(context.Context, *connect.Request[pb.GetAppointmentRequest]) (*connect.Response[pb.Appointment], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.GetAppointment is not implemented"))
+}
+
+func (UnimplementedAppointmentServiceHandler) DeleteAppointment(context.Context, *connect.Request[pb.DeleteAppointmentRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.DeleteAppointment is not implemented"))
+}
+
+func (UnimplementedAppointmentServiceHandler) CancelAppointment(context.Context, *connect.Request[pb.CancelAppointmentRequest]) (*connect.Response[pb.Appointment], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.CancelAppointment is not implemented"))
+}
+
+func (UnimplementedAppointmentServiceHandler) UpdateAppointmentStatus(context.Context, *connect.Request[pb.UpdateAppointmentStatusRequest]) (*connect.Response[pb.Appointment], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.UpdateAppointmentStatus is not implemented"))
+}
+
+func (UnimplementedAppointmentServiceHandler) BatchCreateAppointments(context.Context, *connect.Request[pb.BatchCreateAppointmentsRequest]) (*connect.Response[pb.BatchAppointmentsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.BatchCreateAppointments is not implemented"))
+}
+
+func (UnimplementedAppointmentServiceHandler) BatchDeleteAppointments(context.Context, *connect.Request[pb.BatchDeleteAppointmentsRequest]) (*connect.Response[pb.BatchAppointmentsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.BatchDeleteAppointments is not implemented"))
+}
+
+func (UnimplementedAppointmentServiceHandler) RestoreAppointment(context.Context, *connect.Request[pb.RestoreAppointmentRequest]) (*connect.Response[pb.Appointment], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.RestoreAppointment is not implemented"))
+}
+
+func (UnimplementedAppointmentServiceHandler) ListDeletedAppointments(context.Context, *connect.Request[pb.ListDeletedAppointmentsRequest]) (*connect.Response[pb.ListAppointmentsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.ListDeletedAppointments is not implemented"))
+}
+
+func (UnimplementedAppointmentServiceHandler) PurgeAppointment(context.Context, *connect.Request[pb.PurgeAppointmentRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.PurgeAppointment is not implemented"))
+}
+
+func (UnimplementedAppointmentServiceHandler) ListAppointments(context.Context, *connect.Request[pb.ListAppointmentsRequest]) (*connect.Response[pb.ListAppointmentsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.ListAppointments is not implemented"))
+}
+
+func (UnimplementedAppointmentServiceHandler) ExportICS(context.Context, *connect.Request[pb.ExportICSRequest]) (*connect.Response[pb.ExportICSResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.ExportICS is not implemented"))
+}
+
+func (UnimplementedAppointmentServiceHandler) ImportICS(context.Context, *connect.ClientStream[pb.ImportICSRequest]) (*connect.Response[pb.ImportICSReport], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.ImportICS is not implemented"))
+}
+
+func (UnimplementedAppointmentServiceHandler) ExportAppointments(context.Context, *connect.Request[pb.ExportAppointmentsRequest], *connect.ServerStream[pb.ExportAppointmentsChunk]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.ExportAppointments is not implemented"))
+}
+
+func (UnimplementedAppointmentServiceHandler) ImportAppointments(context.Context, *connect.ClientStream[pb.ImportAppointmentsRequest]) (*connect.Response[pb.ImportAppointmentsReport], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.ImportAppointments is not implemented"))
+}
+
+func (UnimplementedAppointmentServiceHandler) CreateFeedToken(context.Context, *connect.Request[pb.CreateFeedTokenRequest]) (*connect.Response[pb.FeedToken], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.CreateFeedToken is not implemented"))
+}
+
+func (UnimplementedAppointmentServiceHandler) RotateFeedToken(context.Context, *connect.Request[pb.RotateFeedTokenRequest]) (*connect.Response[pb.FeedToken], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.RotateFeedToken is not implemented"))
+}
+
+func (UnimplementedAppointmentServiceHandler) RevokeFeedToken(context.Context, *connect.Request[pb.RevokeFeedTokenRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.RevokeFeedToken is not implemented"))
+}
+
+func (UnimplementedAppointmentServiceHandler) ListFeedTokens(context.Context, *connect.Request[pb.ListFeedTokensRequest]) (*connect.Response[pb.ListFeedTokensResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.ListFeedTokens is not implemented"))
+}
+
+func (UnimplementedAppointmentServiceHandler) HoldSlot(context.Context, *connect.Request[pb.HoldSlotRequest]) (*connect.Response[pb.Appointment], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.HoldSlot is not implemented"))
+}
+
+func (UnimplementedAppointmentServiceHandler) ConfirmHold(context.Context, *connect.Request[pb.ConfirmHoldRequest]) (*connect.Response[pb.Appointment], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.ConfirmHold is not implemented"))
+}
+
+func (UnimplementedAppointmentServiceHandler) GetStatistics(context.Context, *connect.Request[pb.GetStatisticsRequest]) (*connect.Response[pb.GetStatisticsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.GetStatistics is not implemented"))
+}
+
+func (UnimplementedAppointmentServiceHandler) GetCalendarSettings(context.Context, *connect.Request[pb.GetCalendarSettingsRequest]) (*connect.Response[pb.CalendarSettings], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.GetCalendarSettings is not implemented"))
+}
+
+func (UnimplementedAppointmentServiceHandler) UpdateCalendarSettings(context.Context, *connect.Request[pb.UpdateCalendarSettingsRequest]) (*connect.Response[pb.CalendarSettings], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.UpdateCalendarSettings is not implemented"))
+}
+
+func (UnimplementedAppointmentServiceHandler) GetAppointmentHistory(context.Context, *connect.Request[pb.GetAppointmentHistoryRequest]) (*connect.Response[pb.GetAppointmentHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.GetAppointmentHistory is not implemented"))
+}
+
+func (UnimplementedAppointmentServiceHandler) ListAuditEvents(context.Context, *connect.Request[pb.ListAuditEventsRequest]) (*connect.Response[pb.ListAuditEventsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.ListAuditEvents is not implemented"))
+}
+
+func (UnimplementedAppointmentServiceHandler) CreateAPIKey(context.Context, *connect.Request[pb.CreateAPIKeyRequest]) (*connect.Response[pb.APIKey], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.CreateAPIKey is not implemented"))
+}
+
+func (UnimplementedAppointmentServiceHandler) ListAPIKeys(context.Context, *connect.Request[pb.ListAPIKeysRequest]) (*connect.Response[pb.ListAPIKeysResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.ListAPIKeys is not implemented"))
+}
+
+func (UnimplementedAppointmentServiceHandler) RevokeAPIKey(context.Context, *connect.Request[pb.RevokeAPIKeyRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.RevokeAPIKey is not implemented"))
+}
+
+func (UnimplementedAppointmentServiceHandler) StreamAppointments(context.Context, *connect.Request[emptypb.Empty], *connect.ServerStream[pb.AppointmentStreamResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.StreamAppointments is not implemented"))
+}